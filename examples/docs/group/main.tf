@@ -0,0 +1,31 @@
+provider "redpanda" {}
+
+resource "redpanda_resource_group" "example" {
+  name = "example-resource-group"
+}
+
+resource "redpanda_organization_user" "ada" {
+  email = "ada@example.com"
+}
+
+resource "redpanda_group" "platform" {
+  name        = "platform"
+  description = "Platform engineering"
+}
+
+resource "redpanda_group_membership" "ada" {
+  group_id = redpanda_group.platform.id
+  user_id  = redpanda_organization_user.ada.user_id
+}
+
+resource "redpanda_role_binding" "platform_writer" {
+  role_name = "Writer"
+  scope = {
+    resource_type = "RESOURCE_GROUP"
+    resource_id   = redpanda_resource_group.example.id
+  }
+  principal = {
+    type = "GROUP"
+    id   = redpanda_group.platform.id
+  }
+}
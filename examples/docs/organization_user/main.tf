@@ -0,0 +1,11 @@
+provider "redpanda" {}
+
+variable "engineers" {
+  type    = set(string)
+  default = ["ada@example.com", "grace@example.com"]
+}
+
+resource "redpanda_organization_user" "engineer" {
+  for_each = var.engineers
+  email    = each.value
+}
@@ -0,0 +1,59 @@
+provider "redpanda" {}
+
+variable "cluster_id" {
+  type = string
+}
+
+variable "cluster_api_url" {
+  type = string
+}
+
+resource "redpanda_principal_mapping" "example" {
+  cluster_id = var.cluster_id
+
+  # OIDC: use the local part of the email claim, lower-cased.
+  oidc_principal_mapping = "$.email/([^@]+)@.*/$1/L"
+  oidc_group_claim_path  = "$.groups"
+
+  # mTLS: use the certificate's common name, otherwise the full DN.
+  mtls_principal_mapping_rules = [
+    "RULE:CN=([^,]+),.*/$1/L",
+    "DEFAULT",
+  ]
+
+  # Samples are only used to compute the *_preview attributes at plan time.
+  sample_token_claims = jsonencode({
+    sub    = "0f3c6a52"
+    email  = "Alice@example.com"
+    groups = ["engineering"]
+  })
+  sample_certificate_dn = "CN=svc-billing,OU=payments,O=Example"
+}
+
+output "oidc_principal" {
+  value = redpanda_principal_mapping.example.oidc_principal_preview # "User:alice"
+}
+
+resource "redpanda_acl" "engineering_read" {
+  resource_type         = "TOPIC"
+  resource_name         = "orders"
+  resource_pattern_type = "LITERAL"
+  principal             = "Group:engineering"
+  host                  = "*"
+  operation             = "READ"
+  permission_type       = "ALLOW"
+  cluster_api_url       = var.cluster_api_url
+  allow_deletion        = true
+}
+
+resource "redpanda_acl" "billing_write" {
+  resource_type         = "TOPIC"
+  resource_name         = "invoices"
+  resource_pattern_type = "LITERAL"
+  principal             = redpanda_principal_mapping.example.mtls_principal_preview # "User:svc-billing"
+  host                  = "*"
+  operation             = "WRITE"
+  permission_type       = "ALLOW"
+  cluster_api_url       = var.cluster_api_url
+  allow_deletion        = true
+}
@@ -0,0 +1,56 @@
+provider "redpanda" {}
+
+resource "redpanda_resource_group" "example" {
+  name = "example-resource-group"
+}
+
+resource "redpanda_network" "example" {
+  name              = "example-network"
+  resource_group_id = redpanda_resource_group.example.id
+  cloud_provider    = "aws"
+  region            = "us-west-2"
+  cluster_type      = "dedicated"
+  cidr_block        = "10.0.0.0/20"
+}
+
+resource "redpanda_cluster" "replica" {
+  name              = "replica-cluster"
+  resource_group_id = redpanda_resource_group.example.id
+  network_id        = redpanda_network.example.id
+  cloud_provider    = "aws"
+  region            = "us-west-2"
+  cluster_type      = "dedicated"
+  connection_type   = "public"
+  throughput_tier   = "tier-1-aws-v2-arm"
+  zones             = ["us-west-2a", "us-west-2b", "us-west-2c"]
+}
+
+resource "redpanda_cluster" "origin" {
+  name                     = "origin-cluster"
+  resource_group_id        = redpanda_resource_group.example.id
+  network_id               = redpanda_network.example.id
+  cloud_provider           = "aws"
+  region                   = "us-west-2"
+  cluster_type             = "dedicated"
+  connection_type          = "public"
+  throughput_tier          = "tier-1-aws-v2-arm"
+  zones                    = ["us-west-2a", "us-west-2b", "us-west-2c"]
+  read_replica_cluster_ids = [redpanda_cluster.replica.id]
+}
+
+resource "redpanda_topic" "orders" {
+  name            = "orders"
+  partition_count = 6
+  cluster_api_url = redpanda_cluster.origin.cluster_api_url
+
+  tiered_storage = {
+    remote_read  = true
+    remote_write = true
+  }
+}
+
+resource "redpanda_read_replica_topic" "orders" {
+  name              = redpanda_topic.orders.name
+  cluster_api_url   = redpanda_cluster.replica.cluster_api_url
+  source_cluster_id = redpanda_cluster.origin.id
+}
@@ -0,0 +1,31 @@
+provider "redpanda" {}
+
+resource "redpanda_resource_group" "example" {
+  name = "example-resource-group"
+}
+
+resource "redpanda_service_account" "example" {
+  name        = "ci-bot"
+  description = "Service account used by CI workloads."
+  role_bindings = [
+    {
+      role_name = "Reader"
+      scope = {
+        resource_type = "RESOURCE_GROUP"
+        resource_id   = redpanda_resource_group.example.id
+      }
+    },
+  ]
+}
+
+resource "redpanda_role_binding" "writer" {
+  role_name = "Writer"
+  scope = {
+    resource_type = "RESOURCE_GROUP"
+    resource_id   = redpanda_resource_group.example.id
+  }
+  principal = {
+    type = "SERVICE_ACCOUNT"
+    id   = redpanda_service_account.example.id
+  }
+}
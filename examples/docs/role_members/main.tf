@@ -0,0 +1,24 @@
+provider "redpanda" {}
+
+variable "cluster_api_url" {
+  type = string
+}
+
+variable "idp_groups" {
+  description = "IdP groups that should hold the developer role."
+  type        = set(string)
+  default     = ["engineering", "sre"]
+}
+
+resource "redpanda_role" "developer" {
+  name            = "developer"
+  cluster_api_url = var.cluster_api_url
+  allow_deletion  = true
+}
+
+resource "redpanda_role_members" "developer" {
+  role_name       = redpanda_role.developer.name
+  cluster_api_url = var.cluster_api_url
+  principals      = [for g in var.idp_groups : "Group:${g}"]
+  authoritative   = true
+}
@@ -0,0 +1,21 @@
+provider "redpanda" {}
+
+variable "service_account_id" {
+  type = string
+}
+
+ephemeral "redpanda_service_account_credentials" "example" {
+  service_account_id = var.service_account_id
+}
+
+provider "vault" {}
+
+resource "vault_kv_secret_v2" "client_secret" {
+  mount = "secret"
+  name  = "redpanda/ci-bot"
+  data_json_wo = jsonencode({
+    client_id     = ephemeral.redpanda_service_account_credentials.example.client_id
+    client_secret = ephemeral.redpanda_service_account_credentials.example.client_secret
+  })
+  data_json_wo_version = 1
+}
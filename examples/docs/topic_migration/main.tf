@@ -0,0 +1,65 @@
+provider "redpanda" {}
+
+variable "cluster_api_url" {
+  type = string
+}
+
+variable "migrator_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "redpanda_topic" "orders" {
+  name               = "orders"
+  partition_count    = 6
+  replication_factor = 3
+  cluster_api_url    = var.cluster_api_url
+}
+
+resource "redpanda_user" "migrator" {
+  name            = "topic-migrator"
+  password        = var.migrator_password
+  mechanism       = "scram-sha-256"
+  cluster_api_url = var.cluster_api_url
+  allow_deletion  = true
+}
+
+resource "redpanda_secret" "migrator_password" {
+  name                = "TOPIC_MIGRATOR_PASSWORD"
+  secret_data         = base64encode(var.migrator_password)
+  secret_data_version = 1
+  scopes              = ["SCOPE_REDPANDA_CONNECT"]
+  cluster_api_url     = var.cluster_api_url
+  allow_deletion      = true
+}
+
+resource "redpanda_acl" "migrator" {
+  for_each = {
+    "TOPIC:orders"                                 = "READ"
+    "TOPIC:team-a.orders"                          = "WRITE"
+    "GROUP:redpanda-topic-migration-team-a.orders" = "READ"
+  }
+  resource_type         = split(":", each.key)[0]
+  resource_name         = split(":", each.key)[1]
+  resource_pattern_type = "LITERAL"
+  principal             = "User:${redpanda_user.migrator.name}"
+  host                  = "*"
+  operation             = each.value
+  permission_type       = "ALLOW"
+  cluster_api_url       = var.cluster_api_url
+}
+
+resource "redpanda_topic_migration" "orders" {
+  cluster_api_url = var.cluster_api_url
+  source_topic    = redpanda_topic.orders.name
+  target_topic    = "team-a.orders"
+  username        = redpanda_user.migrator.name
+  password_secret = redpanda_secret.migrator_password.name
+  idle_timeout    = "2m"
+
+  timeouts = {
+    create = "3h"
+  }
+
+  depends_on = [redpanda_acl.migrator]
+}
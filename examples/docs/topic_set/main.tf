@@ -0,0 +1,37 @@
+provider "redpanda" {}
+
+variable "cluster_api_url" {
+  type = string
+}
+
+variable "tenants" {
+  type    = set(string)
+  default = ["acme", "globex", "initech"]
+}
+
+resource "redpanda_topic_set" "tenants" {
+  cluster_api_url = var.cluster_api_url
+  parallelism     = 20
+  allow_deletion  = true
+
+  defaults = {
+    partition_count    = 6
+    replication_factor = 3
+    configuration = {
+      "cleanup.policy" = "delete"
+      "retention.ms"   = "7d"
+    }
+  }
+
+  topics = merge(
+    { for t in var.tenants : "tenant.${t}.events" => {} },
+    {
+      "tenant.acme.audit" = {
+        partition_count = 1
+        configuration = {
+          "retention.ms" = "-1"
+        }
+      }
+    },
+  )
+}
@@ -26,6 +26,7 @@ import (
 // in scopedDescriptions.
 var commonDescriptions = map[string]string{
 	"allow_deletion":         "Whether Terraform may destroy this resource. Defaults to false; set to true to enable destruction. After `terraform import`, defaults to false — set to true in your config before running `terraform destroy`.",
+	"client_secret_version":  "Version number for the client secret. Change this value to rotate auth0_client_credentials.client_secret without replacing the service account.",
 	"cluster_api_url":        "The cluster API URL. Changing this will prevent deletion of the resource on the existing cluster. It is generally a better idea to delete an existing resource and create a new one than to change this value unless you are planning to do state imports.",
 	"cluster_type":           "Cluster type. Type is immutable and can only be set on cluster creation. Can be either byoc or dedicated.",
 	"configuration":          "A map of string key/value pairs of topic configurations.",
@@ -46,23 +47,50 @@ var commonDescriptions = map[string]string{
 // are genuinely API facts (constraints, enum values, doc links) should move
 // upstream into cloudv2 proto comments and be dropped here on a pin bump.
 var scopedDescriptions = map[string]string{
-	"Cluster.tags":                                     "Tags placed on cloud resources. Server-managed keys (prefixed with `redpanda-`) are filtered out of state.",
-	"Cluster.cluster_api_url":                          "The URL of the cluster's data plane API.",
-	"Cluster.cloud_storage.skip_destroy":               "If true, cloud storage is not deleted when the cluster is destroyed.",
-	"Cluster.cloud_storage.azure.container_name":       "Name of the Azure storage container.",
-	"Cluster.cloud_storage.azure.storage_account_name": "Name of the Azure storage account.",
-	"Cluster.cloud_storage.gcp.name":                   "Name of the GCP storage bucket.",
-	"Network.state":                                    "Current state of the network.",
-	"ServerlessCluster.console_url":                    "Public Console URL for the serverless cluster.",
-	"ServerlessCluster.console_private_url":            "Private Console URL for the serverless cluster.",
-	"CreateTopicRequest.Topic.partition_count":         "The number of partitions for the topic. Increases are fully supported without data loss. Decreases will destroy and recreate the topic if allow_deletion is set to true (defaults to false).",
-	"CreateUserRequest.User.mechanism":                 "Which authentication method to use. See https://docs.redpanda.com/current/manage/security/authentication/ for more information.",
-	"Pipeline.state":                                   "Desired state of the pipeline: 'running' or 'stopped'. The provider will ensure the pipeline reaches this state after create/update operations.",
-	"ServerlessCluster.cluster_api_url":                "The URL of the dataplane API for the serverless cluster.",
-	"ServerlessCluster.private_link_id":                "Private link ID for the serverless cluster. Must be set if private networking is enabled.",
-	"ServerlessCluster.networking_config.private":      "Private network state. Valid values: STATE_UNSPECIFIED, STATE_DISABLED, STATE_ENABLED.",
-	"ServerlessCluster.networking_config.public":       "Public network state. Valid values: STATE_UNSPECIFIED, STATE_DISABLED, STATE_ENABLED.",
-	"ServerlessPrivateLink.aws_config":                 "AWS-specific configuration. Required when cloud_provider is `aws`.",
+	"Cluster.tags":                                                  "Tags placed on cloud resources. Server-managed keys (prefixed with `redpanda-`) are filtered out of state.",
+	"Cluster.cluster_api_url":                                       "The URL of the cluster's data plane API.",
+	"Cluster.cloud_storage.skip_destroy":                            "If true, cloud storage is not deleted when the cluster is destroyed.",
+	"Cluster.cloud_storage.azure.container_name":                    "Name of the Azure storage container.",
+	"Cluster.cloud_storage.azure.storage_account_name":              "Name of the Azure storage account.",
+	"Cluster.cloud_storage.gcp.name":                                "Name of the GCP storage bucket.",
+	"Network.state":                                                 "Current state of the network.",
+	"ServerlessCluster.console_url":                                 "Public Console URL for the serverless cluster.",
+	"ServerlessCluster.console_private_url":                         "Private Console URL for the serverless cluster.",
+	"CreateACLRequest.principal":                                    "The principal this ACL applies to. Must be prefixed with `User:` (SASL users, mTLS-mapped certificate principals, or `User:*`), `Group:` (OIDC group claims) or `RedpandaRole:` (Redpanda roles).",
+	"CreateTopicRequest.Topic.configuration":                        "A map of string key/value pairs of topic configurations. Keys and values are checked at plan time against the provider's catalog of Redpanda topic properties. Millisecond properties also accept durations such as `7d` and byte properties accept sizes such as `1GiB`.",
+	"CreateTopicRequest.Topic.configuration_mode":                   "How `configuration` is reconciled with the topic's dynamic overrides. `additive` (default) tracks the declared keys and other overrides, ignores server-managed `redpanda.*` keys that are not declared, and applies changes by rewriting the topic configuration. `authoritative` reports every dynamic override as drift, including `redpanda.*` keys, and applies changes with incremental set and delete operations, removing overrides that are not declared.",
+	"CreateTopicRequest.Topic.iceberg":                              "Iceberg integration settings for the topic. Each set attribute is written to the matching `redpanda.iceberg.*` topic property, which must then not also appear in `configuration`. Enabling Iceberg is checked at plan time against the cluster's `iceberg_enabled` cluster property when the cluster can be resolved from `cluster_api_url`.",
+	"CreateTopicRequest.Topic.iceberg.mode":                         "Iceberg mode for the topic (`redpanda.iceberg.mode`). One of `disabled`, `key_value`, `value_schema_id_prefix` or `value_schema_latest`; `value_schema_latest` accepts options such as `value_schema_latest:subject=orders`.",
+	"CreateTopicRequest.Topic.iceberg.partition_spec":               "Iceberg partition spec for the topic's table (`redpanda.iceberg.partition.spec`), e.g. `(hour(redpanda.timestamp))`. Supports bare fields and the `identity`, `year`, `month`, `day`, `hour`, `bucket`, `truncate` and `void` transforms. Uses the cluster default when unset.",
+	"CreateTopicRequest.Topic.iceberg.invalid_record_action":        "What to do with records that cannot be translated to the Iceberg table (`redpanda.iceberg.invalid.record.action`): `drop` or `dlq_table`. Uses the cluster default when unset.",
+	"CreateTopicRequest.Topic.tiered_storage":                       "Tiered storage settings for the topic. Each set attribute is written to the matching topic property, which must then not also appear in `configuration`. Unset attributes use the cluster defaults.",
+	"CreateTopicRequest.Topic.tiered_storage.remote_read":           "Whether the topic serves reads of data that has been moved to object storage (`redpanda.remote.read`).",
+	"CreateTopicRequest.Topic.tiered_storage.remote_write":          "Whether the topic uploads its data to object storage (`redpanda.remote.write`).",
+	"CreateTopicRequest.Topic.tiered_storage.local_retention_bytes": "How much data each partition keeps on local disk once it has been uploaded to object storage (`retention.local.target.bytes`), as a byte count or a size such as `1GiB`. `-1` keeps everything locally.",
+	"CreateTopicRequest.Topic.tiered_storage.local_retention_ms":    "How long data is kept on local disk once it has been uploaded to object storage (`retention.local.target.ms`), in milliseconds or as a duration such as `1d`. `-1` keeps everything locally.",
+	"CreateTopicRequest.Topic.partition_count":                      "The number of partitions for the topic. Increases are fully supported without data loss. Decreases will destroy and recreate the topic if allow_deletion is set to true (defaults to false).",
+	"CreateTopicRequest.Topic.replica_assignments":                  "Manually specify broker ID assignments for partition replicas. If manually assigning replicas, both `replication_factor` and `partition_count` must be -1. Cannot be changed after the topic is created.",
+	"CreateTopicRequest.Topic.replication_factor":                   "The number of replicas every partition must have. Changes are applied in place: the provider sets the replication.factor topic property and Redpanda moves partition replicas in the background. The apply does not wait for the replicas to finish moving. If specifying partitions manually (see `replica_assignments`), set to -1. Or, to use the cluster default replication factor, set to null.",
+	"CreateUserRequest.User.mechanism":                              "Which authentication method to use. See https://docs.redpanda.com/current/manage/security/authentication/ for more information.",
+	"CreateUserRequest.User.generate_password":                      "Have the provider generate the password instead of supplying password or password_wo. The generated value is never stored in state; it is written to the redpanda_secret named by secret_name.",
+	"CreateUserRequest.User.generate_password.length":               "Length of the generated password, between 16 and 128. Defaults to 32.",
+	"CreateUserRequest.User.generate_password.special":              "Whether the generated password includes special characters. Defaults to true.",
+	"CreateUserRequest.User.generate_password.secret_name":          "Name of an existing redpanda_secret on the same cluster. Every generated password is written to it before the user is created or updated.",
+	"CreateUserRequest.User.rotate_after":                           "Rotate the generated password once this duration has passed since it was last generated, e.g. `720h`. Rotation is planned as an in-place update. Requires generate_password.",
+	"CreateUserRequest.User.password_generated_at":                  "RFC 3339 timestamp of the last password generated by the provider. Null unless generate_password is set.",
+	"Role.acl":                                    "ACLs granted to the role principal (`RedpandaRole:<name>`). When set, the role's ACLs are managed by this resource and ACLs added outside Terraform show up as drift.",
+	"Role.acl.host":                               "The host address the ACL applies to. Use `*` for any host.",
+	"Role.acl.operation":                          "The operation that is allowed or denied (e.g. READ).",
+	"Role.acl.permission_type":                    "Whether the operation should be allowed or denied (ALLOW or DENY).",
+	"Role.acl.resource_name":                      "The name of the resource this ACL targets. Use `kafka-cluster` for CLUSTER ACLs.",
+	"Role.acl.resource_pattern_type":              "The pattern to use for matching resource_name (LITERAL or PREFIXED).",
+	"Role.acl.resource_type":                      "The type of resource (TOPIC, GROUP, CLUSTER, TRANSACTIONAL_ID) this ACL targets.",
+	"Pipeline.state":                              "Desired state of the pipeline: 'running' or 'stopped'. The provider will ensure the pipeline reaches this state after create/update operations.",
+	"ServerlessCluster.cluster_api_url":           "The URL of the dataplane API for the serverless cluster.",
+	"ServerlessCluster.private_link_id":           "Private link ID for the serverless cluster. Must be set if private networking is enabled.",
+	"ServerlessCluster.networking_config.private": "Private network state. Valid values: STATE_UNSPECIFIED, STATE_DISABLED, STATE_ENABLED.",
+	"ServerlessCluster.networking_config.public":  "Public network state. Valid values: STATE_UNSPECIFIED, STATE_DISABLED, STATE_ENABLED.",
+	"ServerlessPrivateLink.aws_config":            "AWS-specific configuration. Required when cloud_provider is `aws`.",
 }
 
 // curatedDescription resolves a synthetic/extra attribute's description:
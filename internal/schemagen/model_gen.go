@@ -179,6 +179,28 @@ func {{.ConverterName}}ToList(ctx context.Context, v []{{.NestedType}}) (types.L
 	}
 	return types.ListValueFrom(ctx, elemType, v)
 }
+{{else if eq .Kind "set"}}
+// As{{.RootFieldName}} decodes the root {{.FieldTag}} set attribute into
+// a typed slice. Returns (nil, nil) when null or unknown.
+func (m *{{$.TypeName}}) As{{.RootFieldName}}(ctx context.Context) ([]{{.NestedType}}, diag.Diagnostics) {
+	if m == nil || m.{{.RootFieldName}}.IsNull() || m.{{.RootFieldName}}.IsUnknown() {
+		return nil, nil
+	}
+	out := make([]{{.NestedType}}, 0, len(m.{{.RootFieldName}}.Elements()))
+	d := m.{{.RootFieldName}}.ElementsAs(ctx, &out, false)
+	return out, d
+}
+
+// {{.ConverterName}}ToSet encodes a typed slice back into a types.Set
+// of types.Object elements. A nil receiver returns types.SetNull with
+// the correct element type.
+func {{.ConverterName}}ToSet(ctx context.Context, v []{{.NestedType}}) (types.Set, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: {{.AttrTypesFunc}}()}
+	if v == nil {
+		return types.SetNull(elemType), nil
+	}
+	return types.SetValueFrom(ctx, elemType, v)
+}
 {{else if eq .Kind "map"}}
 // As{{.RootFieldName}} decodes the root {{.FieldTag}} map attribute into
 // a typed map. Returns (nil, nil) when null or unknown.
@@ -117,7 +117,9 @@ func collectConverters(root []SchemaAttr, prefix string) []Converter {
 			kind = "single"
 		case AttrTypeListNested:
 			kind = "list"
-		case AttrTypeMapNested, AttrTypeSetNested:
+		case AttrTypeSetNested:
+			kind = "set"
+		case AttrTypeMapNested:
 			kind = "map"
 		default:
 			continue
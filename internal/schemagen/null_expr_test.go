@@ -224,3 +224,31 @@ func TestNullExprAttrTypeCoverage(t *testing.T) {
 		})
 	}
 }
+
+// Root set_nested attributes must get slice/Set converters: decoding a
+// types.Set into a map fails at runtime.
+func TestGenerateModelSetNestedConverters(t *testing.T) {
+	attrs := []SchemaAttr{
+		{Name: "name", AttrType: AttrTypeString, Required: true},
+		{Name: "acl", AttrType: AttrTypeSetNested, Optional: true, NestedAttrs: []SchemaAttr{
+			{Name: "operation", AttrType: AttrTypeString, Required: true},
+		}},
+	}
+	out, err := GenerateModel(attrs, false, "resource", "role", "redpanda_role")
+	if err != nil {
+		t.Fatalf("GenerateModel: %v", err)
+	}
+	src := string(out)
+	for _, want := range []string{
+		"func (m *ResourceModel) AsAcl(ctx context.Context) ([]AclModel, diag.Diagnostics)",
+		"func AclToSet(ctx context.Context, v []AclModel) (types.Set, diag.Diagnostics)",
+		"types.SetValueFrom(ctx, elemType, v)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated model missing %q:\n%s", want, src)
+		}
+	}
+	if strings.Contains(src, "AclToMap") {
+		t.Errorf("set_nested attribute must not get a map converter:\n%s", src)
+	}
+}
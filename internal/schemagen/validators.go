@@ -76,6 +76,11 @@ var validatorRegistry = map[string]ValidatorDef{
 		AttrType:     "String",
 		ReturnsSlice: true,
 	},
+	"ACLPrincipal": {
+		Expr:     "validators.ACLPrincipal()",
+		Imports:  []string{validatorsImport},
+		AttrType: "String",
+	},
 	"AWSZoneIDValidator": {
 		Expr:     "validators.AWSZoneIDValidator{}",
 		Imports:  []string{validatorsImport},
@@ -86,13 +91,28 @@ var validatorRegistry = map[string]ValidatorDef{
 		Imports:  []string{validatorsImport},
 		AttrType: "Object",
 	},
+	"TopicConfiguration": {
+		Expr:     "validators.TopicConfiguration()",
+		Imports:  []string{validatorsImport},
+		AttrType: "Map",
+	},
+	"TopicProperty": {
+		Parameterized: true,
+		GenFunc: func(_ string, params map[string]string) (string, []string) {
+			return fmt.Sprintf("validators.TopicProperty(%q)", params["name"]), []string{validatorsImport}
+		},
+		AttrType: "String",
+	},
 	"Password": {
 		Parameterized: true,
 		GenFunc: func(_ string, params map[string]string) (string, []string) {
 			field1 := params["field1"]
 			field2 := params["field2"]
-			return fmt.Sprintf(`validators.Password(path.MatchRoot(%q), path.MatchRoot(%q))`, field1, field2),
-				[]string{validatorsImport, "github.com/hashicorp/terraform-plugin-framework/path"}
+			expr := fmt.Sprintf(`validators.Password(path.MatchRoot(%q), path.MatchRoot(%q))`, field1, field2)
+			if generated := params["generated"]; generated != "" {
+				expr += fmt.Sprintf(`.WithGenerated(path.MatchRoot(%q))`, generated)
+			}
+			return expr, []string{validatorsImport, "github.com/hashicorp/terraform-plugin-framework/path"}
 		},
 	},
 	"LengthAtLeast": {
@@ -196,6 +216,32 @@ var validatorRegistry = map[string]ValidatorDef{
 		},
 		AttrType: "String",
 	},
+	"Duration": {
+		Expr:     "validators.Duration()",
+		Imports:  []string{validatorsImport},
+		AttrType: "String",
+	},
+	"AlsoRequiresOneOf": {
+		Parameterized: true,
+		GenFunc: func(_ string, params map[string]string) (string, []string) {
+			parts := strings.Split(params["fields"], "|")
+			roots := make([]string, len(parts))
+			for i, p := range parts {
+				roots[i] = fmt.Sprintf("path.MatchRoot(%q)", strings.TrimSpace(p))
+			}
+			return fmt.Sprintf("validators.AlsoRequiresOneOf(%s)", strings.Join(roots, ", ")),
+				[]string{validatorsImport, "github.com/hashicorp/terraform-plugin-framework/path"}
+		},
+		AttrType: "String",
+	},
+	"Int64Between": {
+		Parameterized: true,
+		GenFunc: func(_ string, params map[string]string) (string, []string) {
+			return fmt.Sprintf("int64validator.Between(%s, %s)", params["min"], params["max"]),
+				[]string{"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"}
+		},
+		AttrType: "Int64",
+	},
 	"RequireTrue": {
 		Expr:     "boolvalidator.Equals(true)",
 		Imports:  []string{"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"},
@@ -17,6 +17,7 @@ package fakes
 
 import (
 	"context"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -35,13 +36,15 @@ type topicRecord struct {
 
 // TopicFake is a stateful in-memory implementation of the TopicService RPCs
 // the provider uses (Create/List/Delete/GetConfigurations/SetConfigurations/
-// SetPartitions). The remaining 4 RPCs inherit Unimplemented.
+// UpdateConfigurations/SetPartitions). The remaining 3 RPCs inherit
+// Unimplemented.
 type TopicFake struct {
 	dataplanev1grpc.UnimplementedTopicServiceServer
 
 	mu             sync.Mutex
 	store          map[string]*topicRecord
 	serverInjected map[string]string
+	createInjected map[string]string
 }
 
 // NewTopicFake returns an empty TopicFake.
@@ -49,6 +52,7 @@ func NewTopicFake() *TopicFake {
 	return &TopicFake{
 		store:          map[string]*topicRecord{},
 		serverInjected: map[string]string{},
+		createInjected: map[string]string{},
 	}
 }
 
@@ -64,6 +68,27 @@ func (f *TopicFake) SetServerInjectedConfig(key, value string) {
 	f.serverInjected[key] = value
 }
 
+// SetCreateInjectedConfig registers a config entry the fake writes into every
+// topic it creates unless the request sets the same key. Unlike
+// SetServerInjectedConfig the entry is an ordinary override that can be
+// deleted, mirroring brokers that pin cluster defaults such as
+// redpanda.remote.write on new topics.
+func (f *TopicFake) SetCreateInjectedConfig(key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.createInjected[key] = value
+}
+
+// SetConfig sets a config entry on an existing topic out of band, the way
+// an operator would with rpk.
+func (f *TopicFake) SetConfig(topic, key, value string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if rec, ok := f.store[topic]; ok {
+		rec.configs[key] = value
+	}
+}
+
 // CreateTopic stores a new topic. AlreadyExists if the name is taken.
 func (f *TopicFake) CreateTopic(_ context.Context, req *dataplanev1.CreateTopicRequest) (*dataplanev1.CreateTopicResponse, error) {
 	t := req.GetTopic()
@@ -86,6 +111,9 @@ func (f *TopicFake) CreateTopic(_ context.Context, req *dataplanev1.CreateTopicR
 		replicationFactor: rf,
 		configs:           map[string]string{},
 	}
+	for k, v := range f.createInjected {
+		rec.configs[k] = v
+	}
 	for _, c := range t.GetConfigs() {
 		rec.configs[c.GetName()] = c.GetValue()
 	}
@@ -168,6 +196,33 @@ func (f *TopicFake) SetTopicConfigurations(_ context.Context, req *dataplanev1.S
 	return &dataplanev1.SetTopicConfigurationsResponse{Configurations: topicConfigsToProto(rec)}, nil
 }
 
+// UpdateTopicConfigurations applies incremental SET/DELETE operations. A
+// replication.factor SET changes the topic's replication factor, the way the
+// broker reassigns replicas, rather than being stored as a config entry.
+func (f *TopicFake) UpdateTopicConfigurations(_ context.Context, req *dataplanev1.UpdateTopicConfigurationsRequest) (*dataplanev1.UpdateTopicConfigurationsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	rec, ok := f.store[req.GetTopicName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "TOPIC_DOES_NOT_EXIST: %s", req.GetTopicName())
+	}
+	for _, c := range req.GetConfigurations() {
+		switch {
+		case c.GetName() == "replication.factor" && c.GetOperation() == dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_SET:
+			rf, err := strconv.ParseInt(c.GetValue(), 10, 32)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid replication.factor %q", c.GetValue())
+			}
+			rec.replicationFactor = int32(rf)
+		case c.GetOperation() == dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_DELETE:
+			delete(rec.configs, c.GetName())
+		default:
+			rec.configs[c.GetName()] = c.GetValue()
+		}
+	}
+	return &dataplanev1.UpdateTopicConfigurationsResponse{Configurations: topicConfigsToProto(rec)}, nil
+}
+
 // SetTopicPartitions updates the partition count.
 func (f *TopicFake) SetTopicPartitions(_ context.Context, req *dataplanev1.SetTopicPartitionsRequest) (*dataplanev1.SetTopicPartitionsResponse, error) {
 	f.mu.Lock()
@@ -21,10 +21,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"google.golang.org/grpc"
 )
 
 // ResourceBase factors out the Metadata/Schema/Configure dispatch that every
@@ -70,7 +73,7 @@ func (b *ResourceBase) Configure(_ context.Context, req resource.ConfigureReques
 		)
 		return
 	}
-	b.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	b.CpCl = controlPlaneClients(p.ControlPlaneClients, p.ControlPlaneConnection)
 	if b.extra != nil {
 		b.extra(p)
 	}
@@ -117,8 +120,61 @@ func (b *DataSourceBase) Configure(_ context.Context, req datasource.ConfigureRe
 		)
 		return
 	}
-	b.CpCl = cloud.NewControlPlaneClientSet(p.ControlPlaneConnection)
+	b.CpCl = controlPlaneClients(p.ControlPlaneClients, p.ControlPlaneConnection)
 	if b.extra != nil {
 		b.extra(p)
 	}
 }
+
+// EphemeralResourceBase factors out the Metadata/Schema/Configure dispatch for
+// ephemeral resources. Embed it in a concrete ephemeral resource type and
+// initialize via NewEphemeralResourceBase. The concrete type still owns Open
+// and any optional framework interfaces (Renew, Close).
+type EphemeralResourceBase struct {
+	CpCl *cloud.ControlPlaneClientSet
+
+	typeName string
+	schemaFn func(context.Context) eschema.Schema
+}
+
+// NewEphemeralResourceBase constructs an EphemeralResourceBase.
+func NewEphemeralResourceBase(typeName string, schemaFn func(context.Context) eschema.Schema) EphemeralResourceBase {
+	return EphemeralResourceBase{typeName: typeName, schemaFn: schemaFn}
+}
+
+// Metadata implements ephemeral.EphemeralResource.
+func (b *EphemeralResourceBase) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = b.typeName
+}
+
+// Schema implements ephemeral.EphemeralResource.
+func (b *EphemeralResourceBase) Schema(ctx context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = b.schemaFn(ctx)
+}
+
+// Configure wires CpCl from the provider's config.Datasource, which the
+// provider also hands to ephemeral resources.
+func (b *EphemeralResourceBase) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	p, ok := req.ProviderData.(config.Datasource)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected config.Datasource, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	b.CpCl = controlPlaneClients(p.ControlPlaneClients, p.ControlPlaneConnection)
+}
+
+// controlPlaneClients returns the provider's shared client set, building one
+// from conn when the provider data carries none (as in tests that wire the
+// connection directly).
+func controlPlaneClients(shared *cloud.ControlPlaneClientSet, conn *grpc.ClientConn) *cloud.ControlPlaneClientSet {
+	if shared != nil {
+		return shared
+	}
+	return cloud.NewControlPlaneClientSet(conn)
+}
@@ -21,6 +21,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	eschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
@@ -198,3 +200,38 @@ func TestDataSourceBase_Configure(t *testing.T) {
 		}
 	})
 }
+
+func TestEphemeralResourceBase_Metadata(t *testing.T) {
+	b := NewEphemeralResourceBase("redpanda_thing", func(context.Context) eschema.Schema { return eschema.Schema{} })
+	resp := &ephemeral.MetadataResponse{}
+	b.Metadata(context.Background(), ephemeral.MetadataRequest{}, resp)
+	if resp.TypeName != "redpanda_thing" {
+		t.Fatalf("TypeName: got %q, want redpanda_thing", resp.TypeName)
+	}
+}
+
+func TestEphemeralResourceBase_Configure(t *testing.T) {
+	t.Run("wrong ProviderData type yields a diagnostic", func(t *testing.T) {
+		b := NewEphemeralResourceBase("redpanda_thing", func(context.Context) eschema.Schema { return eschema.Schema{} })
+		resp := &ephemeral.ConfigureResponse{}
+		b.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: config.Resource{}}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("expected an error diagnostic, got none")
+		}
+		if b.CpCl != nil {
+			t.Fatal("CpCl should remain nil on type mismatch")
+		}
+	})
+
+	t.Run("correct ProviderData wires CpCl", func(t *testing.T) {
+		b := NewEphemeralResourceBase("redpanda_thing", func(context.Context) eschema.Schema { return eschema.Schema{} })
+		resp := &ephemeral.ConfigureResponse{}
+		b.Configure(context.Background(), ephemeral.ConfigureRequest{ProviderData: config.Datasource{}}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		if b.CpCl == nil {
+			t.Fatal("CpCl should be set after Configure")
+		}
+	})
+}
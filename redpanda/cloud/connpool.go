@@ -45,6 +45,7 @@ type ConnPool struct {
 	conns     map[string]*grpc.ClientConn
 	sf        singleflight.Group
 	spawnFunc func(url string, ts oauth2.TokenSource, providerVersion, terraformVersion string) (*grpc.ClientConn, error)
+	lists     *ListCache
 }
 
 // NewConnPool creates a new connection pool with the given authentication and
@@ -56,6 +57,7 @@ func NewConnPool(ts oauth2.TokenSource, providerVersion, terraformVersion string
 		terraformVersion: terraformVersion,
 		conns:            make(map[string]*grpc.ClientConn),
 		spawnFunc:        SpawnConn,
+		lists:            NewListCache(DefaultListCacheTTL),
 	}
 }
 
@@ -71,6 +73,7 @@ func NewConnPoolWithOpts(ts oauth2.TokenSource, providerVersion, terraformVersio
 		spawnFunc: func(url string, ts oauth2.TokenSource, providerVersion, terraformVersion string) (*grpc.ClientConn, error) {
 			return SpawnConnWithOpts(url, ts, providerVersion, terraformVersion, opts)
 		},
+		lists: NewListCache(DefaultListCacheTTL),
 	}
 }
 
@@ -133,6 +136,18 @@ func (p *ConnPool) GetConnection(ctx context.Context, url string) (*grpc.ClientC
 	return conn, nil
 }
 
+// GetCachedConnection returns the shared connection for url, as
+// GetConnection does, with its list RPCs served through the pool's
+// ListCache so that resources targeting the same cluster share list
+// responses.
+func (p *ConnPool) GetCachedConnection(ctx context.Context, url string) (grpc.ClientConnInterface, error) {
+	conn, err := p.GetConnection(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return p.lists.Wrap(url, conn), nil
+}
+
 // CloseAll closes all connections in the pool. This method exists for
 // completeness but is intentionally not called during normal operation — the
 // Terraform plugin framework has no provider-level teardown hook, so
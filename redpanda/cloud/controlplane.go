@@ -19,12 +19,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"strings"
+	"sync"
 
 	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1/controlplanev1grpc"
 	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1beta2/controlplanev1beta2grpc"
 	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/iam/v1/iamv1grpc"
 	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
 	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
 )
 
@@ -43,6 +47,11 @@ type CpClientSet interface {
 	ShadowLinkForID(ctx context.Context, id string) (*controlplanev1.ShadowLink, error)
 	ServiceAccountForID(ctx context.Context, id string) (*iamv1.ServiceAccount, error)
 	ServiceAccountForName(ctx context.Context, name string) (*iamv1.ServiceAccount, error)
+	RoleBindingForID(ctx context.Context, id string) (*iamv1.RoleBinding, error)
+	UserForID(ctx context.Context, id string) (*iamv1.User, error)
+	UserForEmail(ctx context.Context, email string) (*iamv1.User, error)
+	UserInviteForID(ctx context.Context, id string) (*iamv1.UserInvite, error)
+	GroupForID(ctx context.Context, id string) (*iamv1.Group, error)
 }
 
 // ControlPlaneClientSet holds the respective service clients to interact with
@@ -59,6 +68,24 @@ type ControlPlaneClientSet struct {
 	ShadowLink            controlplanev1grpc.ShadowLinkServiceClient
 	ThroughputTier        controlplanev1beta2grpc.ThroughputTierServiceClient
 	ServiceAccount        iamv1grpc.ServiceAccountServiceClient
+	RoleBinding           iamv1grpc.RoleBindingServiceClient
+	User                  iamv1grpc.UserServiceClient
+	UserInvite            iamv1grpc.UserInviteServiceClient
+	Group                 iamv1grpc.GroupServiceClient
+
+	// serverlessByURL memoizes ServerlessClusterForAPIURL. It is nil for
+	// client sets not built by NewControlPlaneClientSet, which resolve on
+	// every call.
+	serverlessByURL *serverlessURLMemo
+}
+
+// serverlessURLMemo holds the serverless cluster, or nil for a dedicated or
+// BYOC cluster, found behind each dataplane API URL. Concurrent first lookups
+// of a URL share one resolution.
+type serverlessURLMemo struct {
+	mu       sync.Mutex
+	clusters map[string]*controlplanev1.ServerlessCluster
+	sf       singleflight.Group
 }
 
 // NewControlPlaneClientSet uses the passed grpc connection to create a control
@@ -76,6 +103,11 @@ func NewControlPlaneClientSet(conn *grpc.ClientConn) *ControlPlaneClientSet {
 		ShadowLink:            controlplanev1grpc.NewShadowLinkServiceClient(conn),
 		ThroughputTier:        controlplanev1beta2grpc.NewThroughputTierServiceClient(conn),
 		ServiceAccount:        iamv1grpc.NewServiceAccountServiceClient(conn),
+		RoleBinding:           iamv1grpc.NewRoleBindingServiceClient(conn),
+		User:                  iamv1grpc.NewUserServiceClient(conn),
+		UserInvite:            iamv1grpc.NewUserInviteServiceClient(conn),
+		Group:                 iamv1grpc.NewGroupServiceClient(conn),
+		serverlessByURL:       &serverlessURLMemo{clusters: map[string]*controlplanev1.ServerlessCluster{}},
 	}
 }
 
@@ -129,6 +161,49 @@ func (c *ControlPlaneClientSet) ServiceAccountForName(ctx context.Context, name
 	}, (*iamv1.ServiceAccount).GetName)
 }
 
+// RoleBindingForID gets the RoleBinding for a given ID.
+func (c *ControlPlaneClientSet) RoleBindingForID(ctx context.Context, id string) (*iamv1.RoleBinding, error) {
+	return getByID("role binding", id, func() (*iamv1.RoleBinding, error) {
+		resp, err := c.RoleBinding.GetRoleBinding(ctx, &iamv1.GetRoleBindingRequest{Id: id})
+		return resp.GetRoleBinding(), err
+	})
+}
+
+// UserForID gets the organization User for a given ID.
+func (c *ControlPlaneClientSet) UserForID(ctx context.Context, id string) (*iamv1.User, error) {
+	return getByID("user", id, func() (*iamv1.User, error) {
+		resp, err := c.User.GetUser(ctx, &iamv1.GetUserRequest{Id: id})
+		return resp.GetUser(), err
+	})
+}
+
+// UserForEmail lists organization users filtering by email and returns the
+// first exact match.
+func (c *ControlPlaneClientSet) UserForEmail(ctx context.Context, email string) (*iamv1.User, error) {
+	return getByName("user", email, func() ([]*iamv1.User, error) {
+		resp, err := c.User.ListUsers(ctx, &iamv1.ListUsersRequest{
+			Filter: &iamv1.ListUsersRequest_Filter{Email: email},
+		})
+		return resp.GetUsers(), err
+	}, (*iamv1.User).GetEmail)
+}
+
+// UserInviteForID gets the UserInvite for a given ID.
+func (c *ControlPlaneClientSet) UserInviteForID(ctx context.Context, id string) (*iamv1.UserInvite, error) {
+	return getByID("user invite", id, func() (*iamv1.UserInvite, error) {
+		resp, err := c.UserInvite.GetUserInvite(ctx, &iamv1.GetUserInviteRequest{Id: id})
+		return resp.GetUserInvite(), err
+	})
+}
+
+// GroupForID gets the Group for a given ID.
+func (c *ControlPlaneClientSet) GroupForID(ctx context.Context, id string) (*iamv1.Group, error) {
+	return getByID("group", id, func() (*iamv1.Group, error) {
+		resp, err := c.Group.GetGroup(ctx, &iamv1.GetGroupRequest{Id: id})
+		return resp.GetGroup(), err
+	})
+}
+
 // ShadowLinkForID gets the shadow link for a given ID.
 func (c *ControlPlaneClientSet) ShadowLinkForID(ctx context.Context, id string) (*controlplanev1.ShadowLink, error) {
 	return getByID("shadow link", id, func() (*controlplanev1.ShadowLink, error) {
@@ -315,6 +390,117 @@ func (c *ControlPlaneClientSet) DataplaneURLForCluster(ctx context.Context, clus
 	return "", fmt.Errorf("cluster %q not found", clusterID)
 }
 
+// ServerlessClusterForAPIURL returns the serverless cluster whose dataplane
+// API is served at apiURL, or nil when apiURL belongs to a dedicated or BYOC
+// cluster. Dataplane hostnames carry the cluster ID as one of their labels, so
+// each label shaped like a cluster ID is looked up with ServerlessClusterForID
+// and the match confirmed against that cluster's dataplane URL. Lookup
+// failures are treated as "not serverless" since the caller only uses the
+// answer to pick an endpoint or word a diagnostic. A nil client set resolves
+// nothing.
+//
+// Answers are remembered for the life of the client set, which the provider
+// shares across resources, since a cluster never moves between tiers and the
+// roles, role assignments and permissions of one cluster all ask about the
+// same URL. Errors are not remembered.
+func (c *ControlPlaneClientSet) ServerlessClusterForAPIURL(ctx context.Context, apiURL string) (*controlplanev1.ServerlessCluster, error) {
+	if c == nil {
+		return nil, nil
+	}
+	m := c.serverlessByURL
+	if m == nil {
+		return c.serverlessClusterForAPIURL(ctx, apiURL)
+	}
+	m.mu.Lock()
+	sl, ok := m.clusters[apiURL]
+	m.mu.Unlock()
+	if ok {
+		return sl, nil
+	}
+	v, err, _ := m.sf.Do(apiURL, func() (any, error) {
+		sl, err := c.serverlessClusterForAPIURL(ctx, apiURL)
+		if err != nil {
+			return nil, err
+		}
+		m.mu.Lock()
+		m.clusters[apiURL] = sl
+		m.mu.Unlock()
+		return sl, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*controlplanev1.ServerlessCluster), nil
+}
+
+func (c *ControlPlaneClientSet) serverlessClusterForAPIURL(ctx context.Context, apiURL string) (*controlplanev1.ServerlessCluster, error) {
+	for _, id := range clusterIDCandidates(apiURL) {
+		sl, err := c.ServerlessClusterForID(ctx, id)
+		if err != nil || sl == nil {
+			continue
+		}
+		url, err := dataplaneURLOrNotReady(sl.GetDataplaneApi().GetUrl(), sl.GetDataplaneApi() != nil, id, true)
+		if err != nil {
+			return nil, err
+		}
+		if apiURLHost(url) == apiURLHost(apiURL) {
+			return sl, nil
+		}
+	}
+	return nil, nil
+}
+
+// ClusterForAPIURL returns the dedicated or BYOC cluster whose dataplane API
+// is served at apiURL, or nil when no such cluster is found, e.g. because
+// apiURL belongs to a serverless cluster. Candidate IDs are taken from the
+// hostname as in ServerlessClusterForAPIURL. A nil client set resolves
+// nothing.
+func (c *ControlPlaneClientSet) ClusterForAPIURL(ctx context.Context, apiURL string) (*controlplanev1.Cluster, error) {
+	if c == nil {
+		return nil, nil
+	}
+	for _, id := range clusterIDCandidates(apiURL) {
+		cl, err := c.ClusterForID(ctx, id)
+		if err != nil || cl == nil {
+			continue
+		}
+		url, err := dataplaneURLOrNotReady(cl.GetDataplaneApi().GetUrl(), cl.GetDataplaneApi() != nil, id, false)
+		if err != nil {
+			return nil, err
+		}
+		if apiURLHost(url) == apiURLHost(apiURL) {
+			return cl, nil
+		}
+	}
+	return nil, nil
+}
+
+// clusterIDPattern matches the 20-character xid form of Redpanda Cloud
+// cluster IDs.
+var clusterIDPattern = regexp.MustCompile(`^[0-9a-v]{20}$`)
+
+// clusterIDCandidates returns the labels of apiURL's hostname that look like
+// cluster IDs, e.g. "cq1k5aq2e1l3jv3vkc5g" for
+// https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com.
+func clusterIDCandidates(apiURL string) []string {
+	var ids []string
+	for _, label := range strings.Split(apiURLHost(apiURL), ".") {
+		if clusterIDPattern.MatchString(label) {
+			ids = append(ids, label)
+		}
+	}
+	return ids
+}
+
+// apiURLHost returns the lower-cased hostname of a cluster API URL, accepting
+// both the https://host and legacy host:443 forms.
+func apiURLHost(apiURL string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(apiURL, "https://"), "http://")
+	host, _, _ = strings.Cut(host, "/")
+	host, _, _ = strings.Cut(host, ":")
+	return strings.ToLower(host)
+}
+
 // dataplaneURLOrNotReady returns the URL when dataplaneAPIPresent is true, or
 // a "not ready yet" diagnostic naming the cluster otherwise. Extracted so the
 // nil-DataplaneApi guard can be unit tested without standing up a fake
@@ -16,8 +16,16 @@
 package cloud
 
 import (
+	"context"
+	"errors"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/controlplane/v1/controlplanev1grpc"
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"google.golang.org/grpc"
 )
 
 // TestUnit_DataplaneURLOrNotReady pins the nil-DataplaneApi guard that
@@ -91,3 +99,114 @@ func TestUnit_DataplaneURLOrNotReady(t *testing.T) {
 		})
 	}
 }
+
+func TestUnit_ClusterIDCandidates(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiURL string
+		want   []string
+	}{
+		{
+			name:   "dataplane URL",
+			apiURL: "https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com",
+			want:   []string{"cq1k5aq2e1l3jv3vkc5g"},
+		},
+		{
+			name:   "legacy host:443 form",
+			apiURL: "api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.byoc.prd.cloud.redpanda.com:443",
+			want:   []string{"cq1k5aq2e1l3jv3vkc5g"},
+		},
+		{
+			name:   "upper-case host and trailing path",
+			apiURL: "https://API-A1B2C3D4.CQ1K5AQ2E1L3JV3VKC5G.fmc.prd.cloud.redpanda.com/",
+			want:   []string{"cq1k5aq2e1l3jv3vkc5g"},
+		},
+		{
+			name:   "no cluster ID label",
+			apiURL: "https://api.cluster-123.example.com",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := clusterIDCandidates(tt.apiURL)
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("clusterIDCandidates(%q) = %v, want %v", tt.apiURL, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnit_ServerlessClusterForAPIURL_NilClientSet(t *testing.T) {
+	var c *ControlPlaneClientSet
+	sl, err := c.ServerlessClusterForAPIURL(t.Context(), "https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com")
+	if sl != nil || err != nil {
+		t.Errorf("got (%v, %v), want (nil, nil)", sl, err)
+	}
+}
+
+// countingServerlessClient serves one serverless cluster and counts the
+// GetServerlessCluster calls made against it.
+type countingServerlessClient struct {
+	controlplanev1grpc.ServerlessClusterServiceClient
+	cluster *controlplanev1.ServerlessCluster
+	calls   atomic.Int32
+}
+
+func (c *countingServerlessClient) GetServerlessCluster(_ context.Context, in *controlplanev1.GetServerlessClusterRequest, _ ...grpc.CallOption) (*controlplanev1.GetServerlessClusterResponse, error) {
+	c.calls.Add(1)
+	if in.GetId() != c.cluster.GetId() {
+		return nil, errors.New("not found")
+	}
+	return &controlplanev1.GetServerlessClusterResponse{ServerlessCluster: c.cluster}, nil
+}
+
+func TestUnit_ServerlessClusterForAPIURL_Memoized(t *testing.T) {
+	const (
+		serverlessURL = "https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com"
+		dedicatedURL  = "https://api-e5f6a7b8.cr2l6bq3f2m4kv4tld6h.byoc.prd.cloud.redpanda.com"
+	)
+	fake := &countingServerlessClient{cluster: &controlplanev1.ServerlessCluster{
+		Id:           "cq1k5aq2e1l3jv3vkc5g",
+		DataplaneApi: &controlplanev1.ServerlessCluster_DataplaneAPI{Url: serverlessURL},
+	}}
+	c := NewControlPlaneClientSet(nil)
+	c.ServerlessCluster = fake
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sl, err := c.ServerlessClusterForAPIURL(t.Context(), serverlessURL)
+			if err != nil || sl.GetId() != "cq1k5aq2e1l3jv3vkc5g" {
+				t.Errorf("got (%v, %v), want the serverless cluster", sl, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := fake.calls.Load(); got != 1 {
+		t.Errorf("GetServerlessCluster called %d times for one URL, want 1", got)
+	}
+
+	for range 3 {
+		sl, err := c.ServerlessClusterForAPIURL(t.Context(), dedicatedURL)
+		if sl != nil || err != nil {
+			t.Errorf("got (%v, %v), want (nil, nil) for a dedicated cluster", sl, err)
+		}
+	}
+	if got := fake.calls.Load(); got != 2 {
+		t.Errorf("GetServerlessCluster called %d times in total, want 2: a non-serverless answer is remembered too", got)
+	}
+}
+
+func TestUnit_ClusterForAPIURL_NilClientSet(t *testing.T) {
+	var c *ControlPlaneClientSet
+	cl, err := c.ClusterForAPIURL(t.Context(), "https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.byoc.prd.cloud.redpanda.com")
+	if cl != nil || err != nil {
+		t.Errorf("got (%v, %v), want (nil, nil)", cl, err)
+	}
+}
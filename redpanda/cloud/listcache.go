@@ -0,0 +1,195 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package cloud
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultListCacheTTL is how long a ListCache serves a list response before
+// asking the cluster again. It only has to span one refresh, where every
+// resource on a cluster lists the same topics, users or ACLs.
+const DefaultListCacheTTL = 10 * time.Second
+
+// ListCache shares the responses of the full listings in cachedMethods
+// between Terraform resources targeting the same cluster. During a refresh,
+// N topic resources would otherwise each issue the same ListTopics call.
+// Other reads, such as the Get calls that state waits poll, always reach the
+// cluster.
+//
+// Responses are keyed by cluster URL, method and request, and served for the
+// cache's TTL. Concurrent identical calls are deduplicated with singleflight,
+// like ConnPool's dials. Any other call to a service of a cluster, such as
+// CreateTopic or DeleteACLs, drops what is cached for that service and
+// cluster, so a resource never reads back a list that predates its own
+// write.
+type ListCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]cachedReply
+	epochs  map[string]uint64
+	sf      singleflight.Group
+}
+
+type cachedReply struct {
+	reply   proto.Message
+	epoch   uint64
+	expires time.Time
+}
+
+// cachedMethods names the RPCs whose responses a ListCache shares.
+var cachedMethods = map[string]bool{
+	"ListTopics":      true,
+	"ListUsers":       true,
+	"ListACLs":        true,
+	"ListRoles":       true,
+	"ListRoleMembers": true,
+}
+
+type bypassListCacheKey struct{}
+
+// BypassListCache returns a context whose calls skip the ListCache, for
+// loops that poll a listing until it changes and so must not be served the
+// reply they already saw.
+func BypassListCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassListCacheKey{}, true)
+}
+
+// NewListCache creates a ListCache that serves responses for ttl.
+func NewListCache(ttl time.Duration) *ListCache {
+	return &ListCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[string]cachedReply),
+		epochs:  make(map[string]uint64),
+	}
+}
+
+// Wrap returns conn with its read RPCs served through the cache. url
+// identifies the cluster conn talks to. A nil cache returns conn unchanged.
+func (c *ListCache) Wrap(url string, conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	if c == nil {
+		return conn
+	}
+	return &listCacheConn{ClientConnInterface: conn, cache: c, url: url}
+}
+
+// listCacheConn serves read RPCs from the cache and invalidates it on every
+// other unary RPC. Streams pass through unchanged.
+type listCacheConn struct {
+	grpc.ClientConnInterface
+	cache *ListCache
+	url   string
+}
+
+// Invoke performs a unary RPC, going through the cache for cachedMethods.
+func (c *listCacheConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	service, name, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	scope := c.url + " " + service
+	if !isReadMethod(name) {
+		// Invalidate on both sides of the write: before, so a list that
+		// started earlier is not stored; after, so one that ran
+		// concurrently with the write is not served.
+		c.cache.invalidate(scope)
+		err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+		c.cache.invalidate(scope)
+		return err
+	}
+	req, reqOK := args.(proto.Message)
+	out, outOK := reply.(proto.Message)
+	if bypass, _ := ctx.Value(bypassListCacheKey{}).(bool); bypass || !reqOK || !outOK || !cachedMethods[name] {
+		return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+	}
+	key := c.url + " " + method + " " + string(b)
+	cached, epoch, ok := c.cache.lookup(key, scope)
+	if !ok {
+		// The epoch is part of the flight key so that a call made after a
+		// write never joins a flight that started before it.
+		v, err, shared := c.cache.sf.Do(key+" "+strconv.FormatUint(epoch, 10), func() (any, error) {
+			fresh := out.ProtoReflect().New().Interface()
+			if err := c.ClientConnInterface.Invoke(ctx, method, args, fresh, opts...); err != nil {
+				return nil, err
+			}
+			c.cache.store(key, scope, fresh, epoch)
+			return fresh, nil
+		})
+		if err != nil {
+			return err
+		}
+		if shared {
+			tflog.Debug(ctx, "shared dataplane read", map[string]any{"url": c.url, "method": method})
+		}
+		if cached, ok = v.(proto.Message); !ok {
+			return errors.New("unexpected type from list cache singleflight")
+		}
+	}
+	proto.Reset(out)
+	proto.Merge(out, cached)
+	return nil
+}
+
+// isReadMethod reports whether an RPC of the given name only reads, and so
+// leaves the cache of its service in place.
+func isReadMethod(name string) bool {
+	return strings.HasPrefix(name, "List") || strings.HasPrefix(name, "Get")
+}
+
+// lookup returns the cached reply for key, if it is fresh and no write to
+// scope happened since it was stored, along with the current epoch of scope.
+func (c *ListCache) lookup(key, scope string) (proto.Message, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	epoch := c.epochs[scope]
+	e, ok := c.entries[key]
+	if !ok || e.epoch != epoch || !c.now().Before(e.expires) {
+		return nil, epoch, false
+	}
+	return e.reply, epoch, true
+}
+
+// store caches reply for key unless scope was written to after epoch.
+func (c *ListCache) store(key, scope string, reply proto.Message, epoch uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.epochs[scope] != epoch {
+		return
+	}
+	c.entries[key] = cachedReply{reply: reply, epoch: epoch, expires: c.now().Add(c.ttl)}
+}
+
+// invalidate drops every reply cached for scope.
+func (c *ListCache) invalidate(scope string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.epochs[scope]++
+}
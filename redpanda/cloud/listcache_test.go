@@ -0,0 +1,162 @@
+package cloud
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	listTopics  = "/redpanda.api.dataplane.v1.TopicService/ListTopics"
+	createTopic = "/redpanda.api.dataplane.v1.TopicService/CreateTopic"
+	listUsers   = "/redpanda.api.dataplane.v1.UserService/ListUsers"
+	getPipeline = "/redpanda.api.dataplane.v1.PipelineService/GetPipeline"
+)
+
+// countingConn answers every unary RPC with a reply naming the request and
+// the number of calls made so far. Calls block until release is closed, if
+// set.
+type countingConn struct {
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (c *countingConn) Invoke(_ context.Context, _ string, args, reply any, _ ...grpc.CallOption) error {
+	n := c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	reply.(*wrapperspb.StringValue).Value = args.(*wrapperspb.StringValue).GetValue() + "#" + string(rune('0'+n))
+	return nil
+}
+
+func (*countingConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, nil
+}
+
+func invoke(t *testing.T, conn grpc.ClientConnInterface, method, req string) string {
+	t.Helper()
+	out := &wrapperspb.StringValue{}
+	require.NoError(t, conn.Invoke(context.Background(), method, wrapperspb.String(req), out))
+	return out.GetValue()
+}
+
+func TestListCache_ServesRepeatedReads(t *testing.T) {
+	inner := &countingConn{}
+	conn := NewListCache(time.Minute).Wrap("cluster-a", inner)
+
+	assert.Equal(t, "all#1", invoke(t, conn, listTopics, "all"))
+	assert.Equal(t, "all#1", invoke(t, conn, listTopics, "all"))
+	assert.Equal(t, "other#2", invoke(t, conn, listTopics, "other"), "a different request is not served from the cache")
+	assert.Equal(t, int32(2), inner.calls.Load())
+}
+
+func TestListCache_CoalescesConcurrentReads(t *testing.T) {
+	inner := &countingConn{release: make(chan struct{})}
+	conn := NewListCache(time.Minute).Wrap("cluster-a", inner)
+
+	const n = 20
+	var wg sync.WaitGroup
+	results := make([]string, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			out := &wrapperspb.StringValue{}
+			assert.NoError(t, conn.Invoke(context.Background(), listTopics, wrapperspb.String("all"), out))
+			results[i] = out.GetValue()
+		}()
+	}
+	require.Eventually(t, func() bool { return inner.calls.Load() == 1 }, time.Second, time.Millisecond)
+	// Give the remaining goroutines time to join the flight in progress.
+	time.Sleep(20 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), inner.calls.Load())
+	for _, r := range results {
+		assert.Equal(t, "all#1", r)
+	}
+}
+
+func TestListCache_Expires(t *testing.T) {
+	inner := &countingConn{}
+	cache := NewListCache(10 * time.Second)
+	now := time.Unix(0, 0)
+	cache.now = func() time.Time { return now }
+	conn := cache.Wrap("cluster-a", inner)
+
+	assert.Equal(t, "all#1", invoke(t, conn, listTopics, "all"))
+	now = now.Add(9 * time.Second)
+	assert.Equal(t, "all#1", invoke(t, conn, listTopics, "all"))
+	now = now.Add(time.Second)
+	assert.Equal(t, "all#2", invoke(t, conn, listTopics, "all"))
+}
+
+func TestListCache_WriteInvalidatesService(t *testing.T) {
+	inner := &countingConn{}
+	conn := NewListCache(time.Minute).Wrap("cluster-a", inner)
+
+	assert.Equal(t, "all#1", invoke(t, conn, listTopics, "all"))
+	assert.Equal(t, "all#2", invoke(t, conn, listUsers, "all"))
+
+	invoke(t, conn, createTopic, "new")
+	assert.Equal(t, "all#4", invoke(t, conn, listTopics, "all"), "topics are listed again after a topic write")
+	assert.Equal(t, "all#2", invoke(t, conn, listUsers, "all"), "other services keep their cached responses")
+}
+
+func TestListCache_PollingSeesChanges(t *testing.T) {
+	inner := &countingConn{}
+	cache := NewListCache(time.Minute)
+	now := time.Unix(0, 0)
+	cache.now = func() time.Time { return now }
+	conn := cache.Wrap("cluster-a", inner)
+
+	// A state wait polls GetPipeline until the pipeline changes state; every
+	// poll, all within the TTL, reaches the cluster.
+	assert.Equal(t, "p#1", invoke(t, conn, getPipeline, "p"))
+	assert.Equal(t, "p#2", invoke(t, conn, getPipeline, "p"))
+
+	// A listing is shared, unless the poll bypasses the cache.
+	assert.Equal(t, "all#3", invoke(t, conn, listTopics, "all"))
+	assert.Equal(t, "all#3", invoke(t, conn, listTopics, "all"))
+	out := &wrapperspb.StringValue{}
+	require.NoError(t, conn.Invoke(BypassListCache(context.Background()), listTopics, wrapperspb.String("all"), out))
+	assert.Equal(t, "all#4", out.GetValue())
+	assert.Equal(t, int32(4), inner.calls.Load())
+}
+
+func TestListCache_SeparatesClusters(t *testing.T) {
+	inner := &countingConn{}
+	cache := NewListCache(time.Minute)
+	a := cache.Wrap("cluster-a", inner)
+	b := cache.Wrap("cluster-b", inner)
+
+	assert.Equal(t, "all#1", invoke(t, a, listTopics, "all"))
+	assert.Equal(t, "all#2", invoke(t, b, listTopics, "all"))
+
+	invoke(t, b, createTopic, "new")
+	assert.Equal(t, "all#1", invoke(t, a, listTopics, "all"), "a write to one cluster keeps the other's responses")
+}
+
+func TestListCache_ReplyIsCopied(t *testing.T) {
+	conn := NewListCache(time.Minute).Wrap("cluster-a", &countingConn{})
+
+	out := &wrapperspb.StringValue{}
+	require.NoError(t, conn.Invoke(context.Background(), listTopics, wrapperspb.String("all"), out))
+	out.Value = "mutated"
+	assert.Equal(t, "all#1", invoke(t, conn, listTopics, "all"))
+}
+
+func TestListCache_NilWrapsNothing(t *testing.T) {
+	inner := &countingConn{}
+	var cache *ListCache
+	assert.Same(t, inner, cache.Wrap("cluster-a", inner))
+}
@@ -30,6 +30,7 @@ type Resource struct {
 	TokenSource            oauth2.TokenSource
 	ByocClient             *utils.ByocClient
 	ControlPlaneConnection *grpc.ClientConn
+	ControlPlaneClients    *cloud.ControlPlaneClientSet
 	DataplaneConnPool      *cloud.ConnPool
 	TerraformVersion       string
 	ProviderVersion        string
@@ -40,6 +41,7 @@ type Resource struct {
 type Datasource struct {
 	TokenSource            oauth2.TokenSource
 	ControlPlaneConnection *grpc.ClientConn
+	ControlPlaneClients    *cloud.ControlPlaneClientSet
 	DataplaneConnPool      *cloud.ConnPool
 	TerraformVersion       string
 	ProviderVersion        string
@@ -108,6 +108,21 @@ func (mr *MockCpClientSetMockRecorder) GetCluster(ctx, in any, opts ...any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCluster", reflect.TypeOf((*MockCpClientSet)(nil).GetCluster), varargs...)
 }
 
+// GroupForID mocks base method.
+func (m *MockCpClientSet) GroupForID(ctx context.Context, id string) (*iamv1.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupForID", ctx, id)
+	ret0, _ := ret[0].(*iamv1.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupForID indicates an expected call of GroupForID.
+func (mr *MockCpClientSetMockRecorder) GroupForID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupForID", reflect.TypeOf((*MockCpClientSet)(nil).GroupForID), ctx, id)
+}
+
 // NetworkForID mocks base method.
 func (m *MockCpClientSet) NetworkForID(ctx context.Context, id string) (*controlplanev1.Network, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +183,21 @@ func (mr *MockCpClientSetMockRecorder) ResourceGroupForName(ctx, name any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceGroupForName", reflect.TypeOf((*MockCpClientSet)(nil).ResourceGroupForName), ctx, name)
 }
 
+// RoleBindingForID mocks base method.
+func (m *MockCpClientSet) RoleBindingForID(ctx context.Context, id string) (*iamv1.RoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RoleBindingForID", ctx, id)
+	ret0, _ := ret[0].(*iamv1.RoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RoleBindingForID indicates an expected call of RoleBindingForID.
+func (mr *MockCpClientSetMockRecorder) RoleBindingForID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RoleBindingForID", reflect.TypeOf((*MockCpClientSet)(nil).RoleBindingForID), ctx, id)
+}
+
 // ServerlessClusterForID mocks base method.
 func (m *MockCpClientSet) ServerlessClusterForID(ctx context.Context, id string) (*controlplanev1.ServerlessCluster, error) {
 	m.ctrl.T.Helper()
@@ -242,3 +272,48 @@ func (mr *MockCpClientSetMockRecorder) ShadowLinkForID(ctx, id any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShadowLinkForID", reflect.TypeOf((*MockCpClientSet)(nil).ShadowLinkForID), ctx, id)
 }
+
+// UserForEmail mocks base method.
+func (m *MockCpClientSet) UserForEmail(ctx context.Context, email string) (*iamv1.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserForEmail", ctx, email)
+	ret0, _ := ret[0].(*iamv1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserForEmail indicates an expected call of UserForEmail.
+func (mr *MockCpClientSetMockRecorder) UserForEmail(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserForEmail", reflect.TypeOf((*MockCpClientSet)(nil).UserForEmail), ctx, email)
+}
+
+// UserForID mocks base method.
+func (m *MockCpClientSet) UserForID(ctx context.Context, id string) (*iamv1.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserForID", ctx, id)
+	ret0, _ := ret[0].(*iamv1.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserForID indicates an expected call of UserForID.
+func (mr *MockCpClientSetMockRecorder) UserForID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserForID", reflect.TypeOf((*MockCpClientSet)(nil).UserForID), ctx, id)
+}
+
+// UserInviteForID mocks base method.
+func (m *MockCpClientSet) UserInviteForID(ctx context.Context, id string) (*iamv1.UserInvite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserInviteForID", ctx, id)
+	ret0, _ := ret[0].(*iamv1.UserInvite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserInviteForID indicates an expected call of UserInviteForID.
+func (mr *MockCpClientSetMockRecorder) UserInviteForID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserInviteForID", reflect.TypeOf((*MockCpClientSet)(nil).UserInviteForID), ctx, id)
+}
@@ -75,6 +75,20 @@ func (mr *MockSRClienterMockRecorder) CreateSchema(ctx, subject, schema any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchema", reflect.TypeOf((*MockSRClienter)(nil).CreateSchema), ctx, subject, schema)
 }
 
+// DeleteSchema mocks base method.
+func (m *MockSRClienter) DeleteSchema(ctx context.Context, subject string, version int, how sr.DeleteHow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchema", ctx, subject, version, how)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchema indicates an expected call of DeleteSchema.
+func (mr *MockSRClienterMockRecorder) DeleteSchema(ctx, subject, version, how any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchema", reflect.TypeOf((*MockSRClienter)(nil).DeleteSchema), ctx, subject, version, how)
+}
+
 // DeleteSubject mocks base method.
 func (m *MockSRClienter) DeleteSubject(ctx context.Context, subject string, how sr.DeleteHow) ([]int, error) {
 	m.ctrl.T.Helper()
@@ -90,6 +104,21 @@ func (mr *MockSRClienterMockRecorder) DeleteSubject(ctx, subject, how any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubject", reflect.TypeOf((*MockSRClienter)(nil).DeleteSubject), ctx, subject, how)
 }
 
+// LookupSchema mocks base method.
+func (m *MockSRClienter) LookupSchema(ctx context.Context, subject string, schema sr.Schema) (sr.SubjectSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupSchema", ctx, subject, schema)
+	ret0, _ := ret[0].(sr.SubjectSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupSchema indicates an expected call of LookupSchema.
+func (mr *MockSRClienterMockRecorder) LookupSchema(ctx, subject, schema any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupSchema", reflect.TypeOf((*MockSRClienter)(nil).LookupSchema), ctx, subject, schema)
+}
+
 // SchemaByVersion mocks base method.
 func (m *MockSRClienter) SchemaByVersion(ctx context.Context, subject string, version int) (sr.SubjectSchema, error) {
 	m.ctrl.T.Helper()
@@ -105,6 +134,21 @@ func (mr *MockSRClienterMockRecorder) SchemaByVersion(ctx, subject, version any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaByVersion", reflect.TypeOf((*MockSRClienter)(nil).SchemaByVersion), ctx, subject, version)
 }
 
+// SchemaReferences mocks base method.
+func (m *MockSRClienter) SchemaReferences(ctx context.Context, subject string, version int) ([]sr.SubjectSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaReferences", ctx, subject, version)
+	ret0, _ := ret[0].([]sr.SubjectSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SchemaReferences indicates an expected call of SchemaReferences.
+func (mr *MockSRClienterMockRecorder) SchemaReferences(ctx, subject, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaReferences", reflect.TypeOf((*MockSRClienter)(nil).SchemaReferences), ctx, subject, version)
+}
+
 // Schemas mocks base method.
 func (m *MockSRClienter) Schemas(ctx context.Context, subject string) ([]sr.SubjectSchema, error) {
 	m.ctrl.T.Helper()
@@ -138,3 +182,18 @@ func (mr *MockSRClienterMockRecorder) SetCompatibility(ctx, c any, subjects ...a
 	varargs := append([]any{ctx, c}, subjects...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompatibility", reflect.TypeOf((*MockSRClienter)(nil).SetCompatibility), varargs...)
 }
+
+// SubjectVersions mocks base method.
+func (m *MockSRClienter) SubjectVersions(ctx context.Context, subject string) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubjectVersions", ctx, subject)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubjectVersions indicates an expected call of SubjectVersions.
+func (mr *MockSRClienterMockRecorder) SubjectVersions(ctx, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubjectVersions", reflect.TypeOf((*MockSRClienter)(nil).SubjectVersions), ctx, subject)
+}
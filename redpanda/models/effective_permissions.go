@@ -0,0 +1,40 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// EffectivePermissions defines the structure for the
+// redpanda_effective_permissions datasource.
+type EffectivePermissions struct {
+	ClusterAPIURL types.String          `tfsdk:"cluster_api_url"`
+	Principal     types.String          `tfsdk:"principal"`
+	Roles         types.List            `tfsdk:"roles"`
+	Permissions   []EffectivePermission `tfsdk:"permissions"`
+	ID            types.String          `tfsdk:"id"`
+}
+
+// EffectivePermission is a single ACL that applies to the principal, together
+// with the principal it was granted to.
+type EffectivePermission struct {
+	Source              types.String `tfsdk:"source"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+	ResourceName        types.String `tfsdk:"resource_name"`
+	ResourcePatternType types.String `tfsdk:"resource_pattern_type"`
+	Host                types.String `tfsdk:"host"`
+	Operation           types.String `tfsdk:"operation"`
+	PermissionType      types.String `tfsdk:"permission_type"`
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package group contains the Terraform models for the redpanda_group and
+// redpanda_group_membership resources and the redpanda_groups datasource.
+package group
+
+import (
+	"fmt"
+	"strings"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ResourceModel represents the Terraform schema for the group resource.
+type ResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+// ToCreateRequest builds the CreateGroupRequest for the model.
+func (m *ResourceModel) ToCreateRequest() *iamv1.CreateGroupRequest {
+	return &iamv1.CreateGroupRequest{
+		Group: &iamv1.GroupCreate{
+			Name:        m.Name.ValueString(),
+			Description: m.Description.ValueString(),
+		},
+	}
+}
+
+// ToUpdate builds the GroupUpdate payload for the model.
+func (m *ResourceModel) ToUpdate() *iamv1.GroupUpdate {
+	return &iamv1.GroupUpdate{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+	}
+}
+
+// Flatten returns a ResourceModel for the given group. An empty description is
+// kept null when the configuration left it unset.
+func Flatten(g *iamv1.Group, prior *ResourceModel) *ResourceModel {
+	description := types.StringValue(g.GetDescription())
+	if g.GetDescription() == "" && (prior == nil || prior.Description.IsNull()) {
+		description = types.StringNull()
+	}
+	return &ResourceModel{
+		ID:          types.StringValue(g.GetId()),
+		Name:        types.StringValue(g.GetName()),
+		Description: description,
+	}
+}
+
+// MembershipModel represents the Terraform schema for the group membership
+// resource.
+type MembershipModel struct {
+	ID      types.String `tfsdk:"id"`
+	GroupID types.String `tfsdk:"group_id"`
+	UserID  types.String `tfsdk:"user_id"`
+}
+
+// MembershipID returns the ID of a group membership: "<group_id>:<user_id>".
+func MembershipID(groupID, userID string) string {
+	return fmt.Sprintf("%s:%s", groupID, userID)
+}
+
+// ParseMembershipID splits a membership ID into its group and user IDs.
+func ParseMembershipID(id string) (groupID, userID string, err error) {
+	groupID, userID, ok := strings.Cut(id, ":")
+	if !ok || groupID == "" || userID == "" {
+		return "", "", fmt.Errorf("invalid group membership ID %q; expected format <group_id>:<user_id>", id)
+	}
+	return groupID, userID, nil
+}
+
+// GroupsDataModel represents the Terraform model for the groups datasource.
+type GroupsDataModel struct {
+	Groups []GroupItem `tfsdk:"groups"`
+}
+
+// GroupItem is a single group in GroupsDataModel.
+type GroupItem struct {
+	ID          string `tfsdk:"id"`
+	Name        string `tfsdk:"name"`
+	Description string `tfsdk:"description"`
+}
+
+// FlattenGroups converts the listed groups into datasource items.
+func FlattenGroups(groups []*iamv1.Group) []GroupItem {
+	out := make([]GroupItem, 0, len(groups))
+	for _, g := range groups {
+		out = append(out, GroupItem{ID: g.GetId(), Name: g.GetName(), Description: g.GetDescription()})
+	}
+	return out
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package group
+
+import (
+	"testing"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatten(t *testing.T) {
+	g := &iamv1.Group{Id: "grp-1", Name: "platform"}
+
+	t.Run("unset description stays null", func(t *testing.T) {
+		got := Flatten(g, &ResourceModel{Description: types.StringNull()})
+		assert.True(t, got.Description.IsNull())
+	})
+
+	t.Run("cleared description is empty", func(t *testing.T) {
+		got := Flatten(g, &ResourceModel{Description: types.StringValue("")})
+		assert.Equal(t, types.StringValue(""), got.Description)
+	})
+
+	t.Run("import sees server description", func(t *testing.T) {
+		got := Flatten(&iamv1.Group{Id: "grp-1", Name: "platform", Description: "Platform team"}, nil)
+		assert.Equal(t, &ResourceModel{
+			ID:          types.StringValue("grp-1"),
+			Name:        types.StringValue("platform"),
+			Description: types.StringValue("Platform team"),
+		}, got)
+	})
+}
+
+func TestParseMembershipID(t *testing.T) {
+	groupID, userID, err := ParseMembershipID(MembershipID("grp-1", "user-1"))
+	require.NoError(t, err)
+	assert.Equal(t, "grp-1", groupID)
+	assert.Equal(t, "user-1", userID)
+
+	for _, id := range []string{"", "grp-1", "grp-1:", ":user-1"} {
+		_, _, err := ParseMembershipID(id)
+		assert.Error(t, err, "id %q", id)
+	}
+}
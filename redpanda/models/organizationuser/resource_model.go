@@ -0,0 +1,89 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package organizationuser contains the Terraform models for the
+// redpanda_organization_user resource and redpanda_organization_users
+// datasource.
+package organizationuser
+
+import (
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Lifecycle states of an organization user.
+const (
+	// StateInvited means the invite has been sent but not yet accepted.
+	StateInvited = "INVITED"
+	// StateActive means the invite was accepted and the user is a member of
+	// the organization.
+	StateActive = "ACTIVE"
+)
+
+// ResourceModel represents the Terraform schema for the organization user
+// resource.
+type ResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Email    types.String `tfsdk:"email"`
+	InviteID types.String `tfsdk:"invite_id"`
+	UserID   types.String `tfsdk:"user_id"`
+	Name     types.String `tfsdk:"name"`
+	State    types.String `tfsdk:"state"`
+}
+
+// FlattenInvite records a pending invite onto m.
+func (m *ResourceModel) FlattenInvite(inv *iamv1.UserInvite) {
+	m.ID = types.StringValue(inv.GetEmail())
+	m.Email = types.StringValue(inv.GetEmail())
+	m.InviteID = types.StringValue(inv.GetId())
+	m.UserID = types.StringNull()
+	m.Name = types.StringNull()
+	m.State = types.StringValue(StateInvited)
+}
+
+// FlattenUser records an organization member onto m. invite_id is kept so the
+// state still shows which invite the user joined through.
+func (m *ResourceModel) FlattenUser(u *iamv1.User) {
+	m.ID = types.StringValue(u.GetEmail())
+	m.Email = types.StringValue(u.GetEmail())
+	if m.InviteID.IsUnknown() {
+		m.InviteID = types.StringNull()
+	}
+	m.UserID = types.StringValue(u.GetId())
+	m.Name = types.StringValue(u.GetName())
+	m.State = types.StringValue(StateActive)
+}
+
+// UsersDataModel represents the Terraform model for the organization users
+// datasource.
+type UsersDataModel struct {
+	Users []UserItem `tfsdk:"users"`
+}
+
+// UserItem is a single organization user in UsersDataModel.
+type UserItem struct {
+	ID    string `tfsdk:"id"`
+	Email string `tfsdk:"email"`
+	Name  string `tfsdk:"name"`
+}
+
+// FlattenUsers converts the listed users into datasource items.
+func FlattenUsers(users []*iamv1.User) []UserItem {
+	out := make([]UserItem, 0, len(users))
+	for _, u := range users {
+		out = append(out, UserItem{ID: u.GetId(), Email: u.GetEmail(), Name: u.GetName()})
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PrincipalMapping defines the structure for the redpanda_principal_mapping
+// resource, which owns the OIDC and mTLS principal-mapping properties of a
+// cluster's configuration.
+type PrincipalMapping struct {
+	ClusterID                 types.String   `tfsdk:"cluster_id"`
+	OIDCPrincipalMapping      types.String   `tfsdk:"oidc_principal_mapping"`
+	OIDCGroupClaimPath        types.String   `tfsdk:"oidc_group_claim_path"`
+	MTLSPrincipalMappingRules types.List     `tfsdk:"mtls_principal_mapping_rules"`
+	SampleTokenClaims         types.String   `tfsdk:"sample_token_claims"`
+	SampleCertificateDN       types.String   `tfsdk:"sample_certificate_dn"`
+	OIDCPrincipalPreview      types.String   `tfsdk:"oidc_principal_preview"`
+	OIDCGroupsPreview         types.List     `tfsdk:"oidc_groups_preview"`
+	MTLSPrincipalPreview      types.String   `tfsdk:"mtls_principal_preview"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	ID                        types.String   `tfsdk:"id"`
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package role
+
+import (
+	"strings"
+
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/enums"
+)
+
+// Principal returns the ACL principal of a role.
+func Principal(roleName string) string {
+	return "RedpandaRole:" + roleName
+}
+
+// Key returns a string that uniquely identifies the ACL binding.
+func (a *AclModel) Key() string {
+	return strings.Join([]string{
+		a.ResourceType.ValueString(),
+		a.ResourceName.ValueString(),
+		a.ResourcePatternType.ValueString(),
+		a.Host.ValueString(),
+		a.Operation.ValueString(),
+		a.PermissionType.ValueString(),
+	}, ":")
+}
+
+// ExpandACLCreate builds the CreateACLRequest granting the ACL to the role.
+func ExpandACLCreate(roleName string, a *AclModel) *dataplanev1.CreateACLRequest {
+	return &dataplanev1.CreateACLRequest{
+		ResourceType:        enums.StringToACLResourceType(a.ResourceType.ValueString()),
+		ResourceName:        a.ResourceName.ValueString(),
+		ResourcePatternType: enums.StringToACLResourcePatternType(a.ResourcePatternType.ValueString()),
+		Principal:           Principal(roleName),
+		Host:                a.Host.ValueString(),
+		Operation:           enums.StringToACLOperation(a.Operation.ValueString()),
+		PermissionType:      enums.StringToACLPermissionType(a.PermissionType.ValueString()),
+	}
+}
+
+// ExpandACLDelete builds a DeleteACLsRequest whose filter matches exactly the
+// given ACL of the role.
+func ExpandACLDelete(roleName string, a *AclModel) *dataplanev1.DeleteACLsRequest {
+	principal := Principal(roleName)
+	return &dataplanev1.DeleteACLsRequest{
+		Filter: &dataplanev1.DeleteACLsRequest_Filter{
+			ResourceType:        enums.StringToACLResourceType(a.ResourceType.ValueString()),
+			ResourceName:        a.ResourceName.ValueStringPointer(),
+			ResourcePatternType: enums.StringToACLResourcePatternType(a.ResourcePatternType.ValueString()),
+			Principal:           &principal,
+			Host:                a.Host.ValueStringPointer(),
+			Operation:           enums.StringToACLOperation(a.Operation.ValueString()),
+			PermissionType:      enums.StringToACLPermissionType(a.PermissionType.ValueString()),
+		},
+	}
+}
+
+// FlattenACLs converts the ListACLs response into the role's acl entries.
+// Policies for other principals are skipped.
+func FlattenACLs(resources []*dataplanev1.ListACLsResponse_Resource, roleName string) []AclModel {
+	principal := Principal(roleName)
+	out := []AclModel{}
+	for _, res := range resources {
+		for _, p := range res.GetAcls() {
+			if p.GetPrincipal() != principal {
+				continue
+			}
+			out = append(out, AclModel{
+				ResourceType:        types.StringValue(enums.ACLResourceTypeToString(res.GetResourceType())),
+				ResourceName:        types.StringValue(res.GetResourceName()),
+				ResourcePatternType: types.StringValue(enums.ACLResourcePatternTypeToString(res.GetResourcePatternType())),
+				Host:                types.StringValue(p.GetHost()),
+				Operation:           types.StringValue(enums.ACLOperationToString(p.GetOperation())),
+				PermissionType:      types.StringValue(enums.ACLPermissionTypeToString(p.GetPermissionType())),
+			})
+		}
+	}
+	return out
+}
+
+// DiffACLs returns the ACLs in desired but not in current (to create) and
+// those in current but not in desired (to delete).
+func DiffACLs(desired, current []AclModel) (create, remove []AclModel) {
+	have := make(map[string]bool, len(current))
+	for i := range current {
+		have[current[i].Key()] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for i := range desired {
+		want[desired[i].Key()] = true
+		if !have[desired[i].Key()] {
+			create = append(create, desired[i])
+		}
+	}
+	for i := range current {
+		if !want[current[i].Key()] {
+			remove = append(remove, current[i])
+		}
+	}
+	return create, remove
+}
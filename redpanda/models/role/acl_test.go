@@ -0,0 +1,99 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package role
+
+import (
+	"testing"
+
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testACL(resourceName, operation string) AclModel {
+	return AclModel{
+		ResourceType:        types.StringValue("TOPIC"),
+		ResourceName:        types.StringValue(resourceName),
+		ResourcePatternType: types.StringValue("LITERAL"),
+		Host:                types.StringValue("*"),
+		Operation:           types.StringValue(operation),
+		PermissionType:      types.StringValue("ALLOW"),
+	}
+}
+
+func TestDiffACLs(t *testing.T) {
+	read := testACL("orders", "READ")
+	write := testACL("orders", "WRITE")
+	extra := testACL("payments", "READ")
+
+	create, remove := DiffACLs([]AclModel{read, write}, []AclModel{read, extra})
+	require.Len(t, create, 1)
+	assert.Equal(t, write.Key(), create[0].Key())
+	require.Len(t, remove, 1)
+	assert.Equal(t, extra.Key(), remove[0].Key())
+
+	create, remove = DiffACLs([]AclModel{read}, []AclModel{read})
+	assert.Empty(t, create)
+	assert.Empty(t, remove)
+
+	create, remove = DiffACLs(nil, []AclModel{read, write})
+	assert.Empty(t, create)
+	assert.Len(t, remove, 2)
+}
+
+func TestFlattenACLs(t *testing.T) {
+	resources := []*dataplanev1.ListACLsResponse_Resource{
+		{
+			ResourceType:        dataplanev1.ACL_RESOURCE_TYPE_TOPIC,
+			ResourceName:        "orders",
+			ResourcePatternType: dataplanev1.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+			Acls: []*dataplanev1.ListACLsResponse_Policy{
+				{
+					Principal:      "RedpandaRole:developer",
+					Host:           "*",
+					Operation:      dataplanev1.ACL_OPERATION_READ,
+					PermissionType: dataplanev1.ACL_PERMISSION_TYPE_ALLOW,
+				},
+				{
+					Principal:      "User:alice",
+					Host:           "*",
+					Operation:      dataplanev1.ACL_OPERATION_READ,
+					PermissionType: dataplanev1.ACL_PERMISSION_TYPE_ALLOW,
+				},
+			},
+		},
+	}
+
+	acls := FlattenACLs(resources, "developer")
+	require.Len(t, acls, 1)
+	want := testACL("orders", "READ")
+	assert.Equal(t, want.Key(), acls[0].Key())
+
+	assert.Empty(t, FlattenACLs(nil, "developer"))
+	assert.NotNil(t, FlattenACLs(nil, "developer"), "an empty list must flatten to an empty (not null) set")
+}
+
+func TestExpandACLDelete(t *testing.T) {
+	a := testACL("orders", "READ")
+	req := ExpandACLDelete("developer", &a)
+	f := req.GetFilter()
+	assert.Equal(t, "RedpandaRole:developer", f.GetPrincipal())
+	assert.Equal(t, "orders", f.GetResourceName())
+	assert.Equal(t, "*", f.GetHost())
+	assert.Equal(t, dataplanev1.ACL_OPERATION_READ, f.GetOperation())
+	assert.Equal(t, dataplanev1.ACL_RESOURCE_TYPE_TOPIC, f.GetResourceType())
+}
@@ -51,6 +51,11 @@ func Flatten(_ context.Context, proto RoleResponse, prev *ResourceModel) (*Resou
 	} else {
 		m.DeleteAcls = types.BoolNull()
 	}
+	if prev != nil && !prev.Acl.IsUnknown() {
+		m.Acl = prev.Acl
+	} else {
+		m.Acl = types.SetNull(types.ObjectType{AttrTypes: AclAttrTypes()})
+	}
 	if prev != nil && !prev.ID.IsUnknown() {
 		m.ID = prev.ID
 	} else {
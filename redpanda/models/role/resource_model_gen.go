@@ -19,11 +19,15 @@
 package role
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // ResourceModel represents the Terraform schema for the role resource.
 type ResourceModel struct {
+	Acl           types.Set    `tfsdk:"acl"`
 	AllowDeletion types.Bool   `tfsdk:"allow_deletion"`
 	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
 	DeleteAcls    types.Bool   `tfsdk:"delete_acls"`
@@ -31,12 +35,66 @@ type ResourceModel struct {
 	Name          types.String `tfsdk:"name"`
 }
 
+// --- Nested typed structs (one per nested message in the proto tree) ---
+
+// AclModel mirrors the nested "acl" attribute. Use the As/To
+// converters on the parent struct to move between types.Object and this
+// typed form.
+type AclModel struct {
+	Host                types.String `tfsdk:"host"`
+	Operation           types.String `tfsdk:"operation"`
+	PermissionType      types.String `tfsdk:"permission_type"`
+	ResourceName        types.String `tfsdk:"resource_name"`
+	ResourcePatternType types.String `tfsdk:"resource_pattern_type"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+}
+
+// --- AttrType tables for nested types (consumed by types.ObjectValueFrom / ObjectNull) ---
+
+// AclAttrTypes returns the attr.Type map for the "acl" nested
+// attribute.
+func AclAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"host":                  types.StringType,
+		"operation":             types.StringType,
+		"permission_type":       types.StringType,
+		"resource_name":         types.StringType,
+		"resource_pattern_type": types.StringType,
+		"resource_type":         types.StringType,
+	}
+}
+
+// --- Root-level converters (types.Object ⇄ typed struct ergonomics) ---
+
+// AsAcl decodes the root acl set attribute into
+// a typed slice. Returns (nil, nil) when null or unknown.
+func (m *ResourceModel) AsAcl(ctx context.Context) ([]AclModel, diag.Diagnostics) {
+	if m == nil || m.Acl.IsNull() || m.Acl.IsUnknown() {
+		return nil, nil
+	}
+	out := make([]AclModel, 0, len(m.Acl.Elements()))
+	d := m.Acl.ElementsAs(ctx, &out, false)
+	return out, d
+}
+
+// AclToSet encodes a typed slice back into a types.Set
+// of types.Object elements. A nil receiver returns types.SetNull with
+// the correct element type.
+func AclToSet(ctx context.Context, v []AclModel) (types.Set, diag.Diagnostics) {
+	elemType := types.ObjectType{AttrTypes: AclAttrTypes()}
+	if v == nil {
+		return types.SetNull(elemType), nil
+	}
+	return types.SetValueFrom(ctx, elemType, v)
+}
+
 // GenerateMinimalResourceModel returns a *ResourceModel populated only with
 // the supplied id; every other field is at its typed null (or its
 // declared minimal_default). Used by resources that need to persist a
 // partial state when Create / Read returns mid-flight.
 func GenerateMinimalResourceModel(id types.String) *ResourceModel {
 	return &ResourceModel{
+		Acl:           types.SetNull(types.ObjectType{AttrTypes: AclAttrTypes()}),
 		AllowDeletion: types.BoolNull(),
 		ClusterAPIURL: types.StringNull(),
 		DeleteAcls:    types.BoolNull(),
@@ -0,0 +1,28 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package models
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// RoleMembers defines the structure for the redpanda_role_members resource,
+// which owns the member set of a single Redpanda role.
+type RoleMembers struct {
+	RoleName      types.String `tfsdk:"role_name"`
+	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
+	Principals    types.Set    `tfsdk:"principals"`
+	Authoritative types.Bool   `tfsdk:"authoritative"`
+	ID            types.String `tfsdk:"id"`
+}
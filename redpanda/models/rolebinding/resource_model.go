@@ -0,0 +1,123 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package rolebinding contains the Terraform models for the redpanda_role_binding
+// resource and datasource.
+package rolebinding
+
+import (
+	"strings"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const scopeResourceTypePrefix = "SCOPE_RESOURCE_TYPE_"
+
+// Scope resource types accepted by the role binding scope, in their Terraform
+// (prefix-stripped) form.
+const (
+	ScopeOrganization      = "ORGANIZATION"
+	ScopeResourceGroup     = "RESOURCE_GROUP"
+	ScopeCluster           = "CLUSTER"
+	ScopeServerlessCluster = "SERVERLESS_CLUSTER"
+)
+
+// Principal types a role can be bound to.
+const (
+	PrincipalServiceAccount = "SERVICE_ACCOUNT"
+	PrincipalUser           = "USER"
+	PrincipalGroup          = "GROUP"
+)
+
+// ScopeResourceTypes lists the valid scope.resource_type values.
+var ScopeResourceTypes = []string{ScopeOrganization, ScopeResourceGroup, ScopeCluster, ScopeServerlessCluster}
+
+// PrincipalTypes lists the valid principal.type values.
+var PrincipalTypes = []string{PrincipalServiceAccount, PrincipalUser, PrincipalGroup}
+
+// ResourceModel represents the Terraform schema for the role binding resource.
+type ResourceModel struct {
+	ID        types.String    `tfsdk:"id"`
+	RoleName  types.String    `tfsdk:"role_name"`
+	Scope     *ScopeModel     `tfsdk:"scope"`
+	Principal *PrincipalModel `tfsdk:"principal"`
+}
+
+// ScopeModel is the resource a role is granted on.
+type ScopeModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+}
+
+// PrincipalModel is the identity a role is granted to.
+type PrincipalModel struct {
+	Type types.String `tfsdk:"type"`
+	ID   types.String `tfsdk:"id"`
+}
+
+// ToCreateRequest builds the CreateRoleBindingRequest for the model. Users and
+// service accounts are both accounts to the IAM API; groups are bound by
+// group ID.
+func (m *ResourceModel) ToCreateRequest() *iamv1.CreateRoleBindingRequest {
+	rb := &iamv1.RoleBindingCreate{
+		RoleName: m.RoleName.ValueString(),
+		Scope:    m.Scope.toProto(),
+	}
+	if m.Principal.Type.ValueString() == PrincipalGroup {
+		rb.GroupId = m.Principal.ID.ValueString()
+	} else {
+		rb.AccountId = m.Principal.ID.ValueString()
+	}
+	return &iamv1.CreateRoleBindingRequest{RoleBinding: rb}
+}
+
+// Flatten returns a ResourceModel for the given role binding. accountType is
+// the principal type to record for account-bound bindings: the API does not
+// say whether an account ID belongs to a user or a service account, so the
+// caller resolves it (usually from prior state).
+func Flatten(rb *iamv1.RoleBinding, accountType string) *ResourceModel {
+	principal := &PrincipalModel{Type: types.StringValue(accountType), ID: types.StringValue(rb.GetAccountId())}
+	if rb.GetGroupId() != "" {
+		principal = &PrincipalModel{Type: types.StringValue(PrincipalGroup), ID: types.StringValue(rb.GetGroupId())}
+	}
+	return &ResourceModel{
+		ID:       types.StringValue(rb.GetId()),
+		RoleName: types.StringValue(rb.GetRoleName()),
+		Scope: &ScopeModel{
+			ResourceType: types.StringValue(strings.TrimPrefix(rb.GetScope().GetResourceType().String(), scopeResourceTypePrefix)),
+			ResourceID:   types.StringValue(rb.GetScope().GetResourceId()),
+		},
+		Principal: principal,
+	}
+}
+
+// PrincipalTypeOf returns the principal type recorded in m, or "" when m has
+// none.
+func (m *ResourceModel) PrincipalTypeOf() string {
+	if m == nil || m.Principal == nil {
+		return ""
+	}
+	return m.Principal.Type.ValueString()
+}
+
+func (s *ScopeModel) toProto() *iamv1.RoleBinding_Scope {
+	return &iamv1.RoleBinding_Scope{
+		ResourceType: iamv1.RoleBinding_ScopeResourceType(
+			iamv1.RoleBinding_ScopeResourceType_value[scopeResourceTypePrefix+s.ResourceType.ValueString()],
+		),
+		ResourceId: s.ResourceID.ValueString(),
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package rolebinding
+
+import (
+	"testing"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceModel_ToCreateRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *PrincipalModel
+		wantAcct  string
+		wantGroup string
+	}{
+		{
+			name:      "service account binds by account ID",
+			principal: &PrincipalModel{Type: types.StringValue(PrincipalServiceAccount), ID: types.StringValue("sa-1")},
+			wantAcct:  "sa-1",
+		},
+		{
+			name:      "user binds by account ID",
+			principal: &PrincipalModel{Type: types.StringValue(PrincipalUser), ID: types.StringValue("user-1")},
+			wantAcct:  "user-1",
+		},
+		{
+			name:      "group binds by group ID",
+			principal: &PrincipalModel{Type: types.StringValue(PrincipalGroup), ID: types.StringValue("grp-1")},
+			wantGroup: "grp-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &ResourceModel{
+				RoleName:  types.StringValue("Writer"),
+				Scope:     &ScopeModel{ResourceType: types.StringValue(ScopeResourceGroup), ResourceID: types.StringValue("rg-1")},
+				Principal: tt.principal,
+			}
+			rb := m.ToCreateRequest().GetRoleBinding()
+			assert.Equal(t, "Writer", rb.GetRoleName())
+			assert.Equal(t, iamv1.RoleBinding_SCOPE_RESOURCE_TYPE_RESOURCE_GROUP, rb.GetScope().GetResourceType())
+			assert.Equal(t, "rg-1", rb.GetScope().GetResourceId())
+			assert.Equal(t, tt.wantAcct, rb.GetAccountId())
+			assert.Equal(t, tt.wantGroup, rb.GetGroupId())
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	t.Run("account binding records the given type", func(t *testing.T) {
+		got := Flatten(&iamv1.RoleBinding{
+			Id:        "rb-1",
+			RoleName:  "Reader",
+			AccountId: "user-1",
+			Scope: &iamv1.RoleBinding_Scope{
+				ResourceType: iamv1.RoleBinding_SCOPE_RESOURCE_TYPE_SERVERLESS_CLUSTER,
+				ResourceId:   "c-1",
+			},
+		}, PrincipalUser)
+		assert.Equal(t, &ResourceModel{
+			ID:        types.StringValue("rb-1"),
+			RoleName:  types.StringValue("Reader"),
+			Scope:     &ScopeModel{ResourceType: types.StringValue(ScopeServerlessCluster), ResourceID: types.StringValue("c-1")},
+			Principal: &PrincipalModel{Type: types.StringValue(PrincipalUser), ID: types.StringValue("user-1")},
+		}, got)
+	})
+
+	t.Run("group binding ignores the account type", func(t *testing.T) {
+		got := Flatten(&iamv1.RoleBinding{Id: "rb-2", GroupId: "grp-1"}, PrincipalServiceAccount)
+		assert.Equal(t, &PrincipalModel{Type: types.StringValue(PrincipalGroup), ID: types.StringValue("grp-1")}, got.Principal)
+	})
+}
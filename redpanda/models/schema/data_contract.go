@@ -0,0 +1,343 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schema
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+// Metadata is the data contract metadata attached to a schema version:
+// free-form properties, per-path tags, and the names of sensitive fields.
+type Metadata struct {
+	Properties types.Map `tfsdk:"properties"`
+	Tags       types.Map `tfsdk:"tags"`
+	Sensitive  types.Set `tfsdk:"sensitive"`
+}
+
+// RuleSet groups the domain and migration rules of a data contract.
+type RuleSet struct {
+	DomainRules    types.List `tfsdk:"domain_rules"`
+	MigrationRules types.List `tfsdk:"migration_rules"`
+}
+
+// RuleObjectType is the object type of a single domain or migration rule.
+var RuleObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":       types.StringType,
+		"doc":        types.StringType,
+		"kind":       types.StringType,
+		"mode":       types.StringType,
+		"type":       types.StringType,
+		"tags":       types.ListType{ElemType: types.StringType},
+		"params":     types.MapType{ElemType: types.StringType},
+		"expr":       types.StringType,
+		"on_success": types.StringType,
+		"on_failure": types.StringType,
+		"disabled":   types.BoolType,
+	},
+}
+
+// NewMetadata converts registry metadata into its Terraform model. Returns nil
+// (a null attribute) when the registry reports no metadata.
+func NewMetadata(md *sr.SchemaMetadata) *Metadata {
+	md = normalizeMetadata(md)
+	if md == nil {
+		return nil
+	}
+
+	out := &Metadata{
+		Properties: types.MapNull(types.StringType),
+		Tags:       types.MapNull(types.ListType{ElemType: types.StringType}),
+		Sensitive:  types.SetNull(types.StringType),
+	}
+	if len(md.Properties) > 0 {
+		props := make(map[string]attr.Value, len(md.Properties))
+		for k, v := range md.Properties {
+			props[k] = types.StringValue(v)
+		}
+		out.Properties = types.MapValueMust(types.StringType, props)
+	}
+	if len(md.Tags) > 0 {
+		tags := make(map[string]attr.Value, len(md.Tags))
+		for k, v := range md.Tags {
+			tags[k] = stringList(v)
+		}
+		out.Tags = types.MapValueMust(types.ListType{ElemType: types.StringType}, tags)
+	}
+	if len(md.Sensitive) > 0 {
+		elems := make([]attr.Value, 0, len(md.Sensitive))
+		for _, s := range md.Sensitive {
+			elems = append(elems, types.StringValue(s))
+		}
+		out.Sensitive = types.SetValueMust(types.StringType, elems)
+	}
+	return out
+}
+
+// NewRuleSet converts a registry rule set into its Terraform model. Returns nil
+// (a null attribute) when the registry reports no rules.
+func NewRuleSet(rs *sr.SchemaRuleSet) (*RuleSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rs = normalizeRuleSet(rs)
+	if rs == nil {
+		return nil, diags
+	}
+
+	domain, d := rulesToTerraform(rs.DomainRules)
+	diags.Append(d...)
+	migration, d := rulesToTerraform(rs.MigrationRules)
+	diags.Append(d...)
+	return &RuleSet{DomainRules: domain, MigrationRules: migration}, diags
+}
+
+// ToSR converts the Terraform metadata model to the registry form. Null or
+// empty metadata yields nil so the field is omitted from the request.
+func (m *Metadata) ToSR() *sr.SchemaMetadata {
+	if m == nil {
+		return nil
+	}
+	md := &sr.SchemaMetadata{
+		Properties: stringMap(m.Properties),
+		Sensitive:  stringSlice(m.Sensitive.Elements()),
+	}
+	if !m.Tags.IsNull() && !m.Tags.IsUnknown() {
+		md.Tags = make(map[string][]string, len(m.Tags.Elements()))
+		for k, v := range m.Tags.Elements() {
+			if l, ok := v.(types.List); ok {
+				md.Tags[k] = stringSlice(l.Elements())
+			}
+		}
+	}
+	return normalizeMetadata(md)
+}
+
+// ToSR converts the Terraform rule set model to the registry form. Null or
+// empty rule sets yield nil so the field is omitted from the request.
+func (rs *RuleSet) ToSR() *sr.SchemaRuleSet {
+	if rs == nil {
+		return nil
+	}
+	return normalizeRuleSet(&sr.SchemaRuleSet{
+		DomainRules:    rulesFromTerraform(rs.DomainRules),
+		MigrationRules: rulesFromTerraform(rs.MigrationRules),
+	})
+}
+
+// MetadataEquivalent reports whether two registry metadata values carry the
+// same content, ignoring nil-vs-empty collections and sensitive-field order.
+func MetadataEquivalent(a, b *sr.SchemaMetadata) bool {
+	return reflect.DeepEqual(normalizeMetadata(a), normalizeMetadata(b))
+}
+
+// RuleSetEquivalent reports whether two registry rule sets carry the same
+// rules, ignoring nil-vs-empty collections.
+func RuleSetEquivalent(a, b *sr.SchemaRuleSet) bool {
+	return reflect.DeepEqual(normalizeRuleSet(a), normalizeRuleSet(b))
+}
+
+// normalizeMetadata returns a copy of md with empty collections set to nil and
+// sensitive fields sorted, or nil when md carries nothing.
+func normalizeMetadata(md *sr.SchemaMetadata) *sr.SchemaMetadata {
+	if md == nil {
+		return nil
+	}
+	out := &sr.SchemaMetadata{}
+	if len(md.Properties) > 0 {
+		out.Properties = md.Properties
+	}
+	if len(md.Tags) > 0 {
+		out.Tags = make(map[string][]string, len(md.Tags))
+		for k, v := range md.Tags {
+			if len(v) == 0 {
+				v = nil
+			}
+			out.Tags[k] = v
+		}
+	}
+	if len(md.Sensitive) > 0 {
+		out.Sensitive = slices.Clone(md.Sensitive)
+		slices.Sort(out.Sensitive)
+	}
+	if out.Properties == nil && out.Tags == nil && out.Sensitive == nil {
+		return nil
+	}
+	return out
+}
+
+// normalizeRuleSet returns a copy of rs with empty collections set to nil, or
+// nil when rs carries no rules.
+func normalizeRuleSet(rs *sr.SchemaRuleSet) *sr.SchemaRuleSet {
+	if rs == nil || (len(rs.DomainRules) == 0 && len(rs.MigrationRules) == 0) {
+		return nil
+	}
+	normalize := func(rules []sr.SchemaRule) []sr.SchemaRule {
+		if len(rules) == 0 {
+			return nil
+		}
+		out := make([]sr.SchemaRule, 0, len(rules))
+		for _, r := range rules {
+			if len(r.Tags) == 0 {
+				r.Tags = nil
+			}
+			if len(r.Params) == 0 {
+				r.Params = nil
+			}
+			out = append(out, r)
+		}
+		return out
+	}
+	return &sr.SchemaRuleSet{
+		DomainRules:    normalize(rs.DomainRules),
+		MigrationRules: normalize(rs.MigrationRules),
+	}
+}
+
+// rulesToTerraform converts registry rules into a list of rule objects. Empty
+// optional fields are reported as null so that omitted arguments round-trip.
+func rulesToTerraform(rules []sr.SchemaRule) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if len(rules) == 0 {
+		return types.ListNull(RuleObjectType), diags
+	}
+
+	elems := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		tags := types.ListNull(types.StringType)
+		if len(rule.Tags) > 0 {
+			tags = stringList(rule.Tags)
+		}
+		params := types.MapNull(types.StringType)
+		if len(rule.Params) > 0 {
+			p := make(map[string]attr.Value, len(rule.Params))
+			for k, v := range rule.Params {
+				p[k] = types.StringValue(v)
+			}
+			params = types.MapValueMust(types.StringType, p)
+		}
+		obj, d := types.ObjectValue(RuleObjectType.AttrTypes, map[string]attr.Value{
+			"name":       types.StringValue(rule.Name),
+			"doc":        optionalString(rule.Doc),
+			"kind":       types.StringValue(rule.Kind.String()),
+			"mode":       types.StringValue(rule.Mode.String()),
+			"type":       types.StringValue(rule.Type),
+			"tags":       tags,
+			"params":     params,
+			"expr":       optionalString(rule.Expr),
+			"on_success": optionalString(rule.OnSuccess),
+			"on_failure": optionalString(rule.OnFailure),
+			"disabled":   types.BoolValue(rule.Disabled),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+
+	list, d := types.ListValue(RuleObjectType, elems)
+	diags.Append(d...)
+	return list, diags
+}
+
+// rulesFromTerraform converts a list of rule objects to registry rules. Kind
+// and mode are validated by the schema, so unparsable values are left at their
+// zero value.
+func rulesFromTerraform(list types.List) []sr.SchemaRule {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	rules := make([]sr.SchemaRule, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		rule := sr.SchemaRule{
+			Name:      attrString(attrs["name"]),
+			Doc:       attrString(attrs["doc"]),
+			Type:      attrString(attrs["type"]),
+			Expr:      attrString(attrs["expr"]),
+			OnSuccess: attrString(attrs["on_success"]),
+			OnFailure: attrString(attrs["on_failure"]),
+		}
+		_ = rule.Kind.UnmarshalText([]byte(attrString(attrs["kind"])))
+		_ = rule.Mode.UnmarshalText([]byte(attrString(attrs["mode"])))
+		if tags, ok := attrs["tags"].(types.List); ok && !tags.IsNull() && !tags.IsUnknown() {
+			rule.Tags = stringSlice(tags.Elements())
+		}
+		if params, ok := attrs["params"].(types.Map); ok {
+			rule.Params = stringMap(params)
+		}
+		if disabled, ok := attrs["disabled"].(types.Bool); ok {
+			rule.Disabled = disabled.ValueBool()
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func attrString(v attr.Value) string {
+	if s, ok := v.(types.String); ok {
+		return s.ValueString()
+	}
+	return ""
+}
+
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func stringList(values []string) types.List {
+	elems := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func stringSlice(elems []attr.Value) []string {
+	if len(elems) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(elems))
+	for _, e := range elems {
+		if s, ok := e.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			out = append(out, s.ValueString())
+		}
+	}
+	return out
+}
+
+func stringMap(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() || len(m.Elements()) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(m.Elements()))
+	for k, v := range m.Elements() {
+		if s, ok := v.(types.String); ok {
+			out[k] = s.ValueString()
+		}
+	}
+	return out
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+func TestMetadata_RoundTrip(t *testing.T) {
+	in := &sr.SchemaMetadata{
+		Properties: map[string]string{"owner": "governance", "contract": "v2"},
+		Tags:       map[string][]string{"User.email": {"PII"}, "User.ssn": {"PII", "RESTRICTED"}},
+		Sensitive:  []string{"ssn", "email"},
+	}
+
+	md := NewMetadata(in)
+	require.NotNil(t, md)
+	assert.Len(t, md.Properties.Elements(), 2)
+	assert.Len(t, md.Tags.Elements(), 2)
+	assert.Len(t, md.Sensitive.Elements(), 2)
+
+	assert.True(t, MetadataEquivalent(in, md.ToSR()), "metadata must survive a round trip")
+}
+
+func TestMetadata_EmptyIsNull(t *testing.T) {
+	assert.Nil(t, NewMetadata(nil))
+	assert.Nil(t, NewMetadata(&sr.SchemaMetadata{Properties: map[string]string{}}))
+
+	md := &Metadata{
+		Properties: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+		Tags:       types.MapNull(types.ListType{ElemType: types.StringType}),
+		Sensitive:  types.SetNull(types.StringType),
+	}
+	assert.Nil(t, md.ToSR(), "configured-but-empty metadata is omitted from requests")
+	assert.Nil(t, (*Metadata)(nil).ToSR())
+}
+
+func TestMetadataEquivalent(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b *sr.SchemaMetadata
+		want bool
+	}{
+		{name: "both nil", want: true},
+		{name: "nil vs empty", b: &sr.SchemaMetadata{Tags: map[string][]string{}}, want: true},
+		{
+			name: "sensitive order ignored",
+			a:    &sr.SchemaMetadata{Sensitive: []string{"a", "b"}},
+			b:    &sr.SchemaMetadata{Sensitive: []string{"b", "a"}},
+			want: true,
+		},
+		{
+			name: "property value differs",
+			a:    &sr.SchemaMetadata{Properties: map[string]string{"owner": "a"}},
+			b:    &sr.SchemaMetadata{Properties: map[string]string{"owner": "b"}},
+		},
+		{
+			name: "tag added",
+			a:    &sr.SchemaMetadata{Tags: map[string][]string{"f": {"PII"}}},
+			b:    &sr.SchemaMetadata{Tags: map[string][]string{"f": {"PII", "GDPR"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MetadataEquivalent(tt.a, tt.b))
+		})
+	}
+}
+
+func TestRuleSet_RoundTrip(t *testing.T) {
+	in := &sr.SchemaRuleSet{
+		DomainRules: []sr.SchemaRule{
+			{
+				Name:      "checkEmail",
+				Kind:      sr.SchemaRuleKindCondition,
+				Mode:      sr.SchemaRuleModeWrite,
+				Type:      "CEL",
+				Expr:      "message.email.contains('@')",
+				OnFailure: "DLQ",
+				Params:    map[string]string{"dlq.topic": "bad-users"},
+			},
+			{
+				Name:     "encryptPII",
+				Kind:     sr.SchemaRuleKindTransform,
+				Mode:     sr.SchemaRuleModeWriteRead,
+				Type:     "ENCRYPT",
+				Tags:     []string{"PII"},
+				Disabled: true,
+			},
+		},
+		MigrationRules: []sr.SchemaRule{
+			{
+				Name: "renameField",
+				Kind: sr.SchemaRuleKindTransform,
+				Mode: sr.SchemaRuleModeUpgrade,
+				Type: "JSONATA",
+				Expr: "$merge([$sift($, function($v, $k) {$k != 'name'}), {'full_name': $.'name'}])",
+			},
+		},
+	}
+
+	rs, diags := NewRuleSet(in)
+	require.False(t, diags.HasError(), "%v", diags)
+	require.NotNil(t, rs)
+	require.Len(t, rs.DomainRules.Elements(), 2)
+	require.Len(t, rs.MigrationRules.Elements(), 1)
+
+	first := rs.DomainRules.Elements()[0].(types.Object).Attributes()
+	assert.Equal(t, types.StringValue("CONDITION"), first["kind"])
+	assert.Equal(t, types.StringValue("WRITE"), first["mode"])
+	assert.True(t, first["doc"].IsNull(), "empty doc must be null so an omitted argument round-trips")
+	assert.True(t, first["tags"].IsNull())
+	assert.Equal(t, types.BoolValue(false), first["disabled"])
+
+	assert.True(t, RuleSetEquivalent(in, rs.ToSR()), "rule set must survive a round trip")
+}
+
+func TestRuleSet_EmptyIsNull(t *testing.T) {
+	rs, diags := NewRuleSet(&sr.SchemaRuleSet{DomainRules: []sr.SchemaRule{}})
+	require.False(t, diags.HasError())
+	assert.Nil(t, rs)
+
+	empty := &RuleSet{
+		DomainRules:    types.ListNull(RuleObjectType),
+		MigrationRules: types.ListValueMust(RuleObjectType, []attr.Value{}),
+	}
+	assert.Nil(t, empty.ToSR())
+}
+
+func TestResourceModel_UpdateFromSchema_DataContract(t *testing.T) {
+	t.Run("registry metadata populates null state", func(t *testing.T) {
+		model := &ResourceModel{
+			Schema:     types.StringValue(`{"type":"string"}`),
+			SchemaType: types.StringValue("AVRO"),
+			References: types.ListNull(referencesObjType),
+		}
+		diags := model.UpdateFromSchema(sr.SubjectSchema{
+			Schema: sr.Schema{
+				Schema:         `{"type":"string"}`,
+				Type:           sr.TypeAvro,
+				SchemaMetadata: &sr.SchemaMetadata{Properties: map[string]string{"owner": "a"}},
+			},
+		})
+		require.False(t, diags.HasError())
+		require.NotNil(t, model.Metadata)
+		assert.Equal(t, map[string]string{"owner": "a"}, model.Metadata.ToSR().Properties)
+		assert.Nil(t, model.RuleSet)
+	})
+
+	t.Run("equivalent configured form is preserved", func(t *testing.T) {
+		configured := &Metadata{
+			Properties: types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			Tags:       types.MapNull(types.ListType{ElemType: types.StringType}),
+			Sensitive:  types.SetNull(types.StringType),
+		}
+		model := &ResourceModel{
+			Schema:     types.StringValue(`{"type":"string"}`),
+			SchemaType: types.StringValue("AVRO"),
+			References: types.ListNull(referencesObjType),
+			Metadata:   configured,
+		}
+		model.UpdateFromSchema(sr.SubjectSchema{
+			Schema: sr.Schema{Schema: `{"type":"string"}`, Type: sr.TypeAvro},
+		})
+		assert.Same(t, configured, model.Metadata, "configured empty properties must not churn to null")
+	})
+
+	t.Run("out-of-band removal surfaces as drift", func(t *testing.T) {
+		model := &ResourceModel{
+			Schema:     types.StringValue(`{"type":"string"}`),
+			SchemaType: types.StringValue("AVRO"),
+			References: types.ListNull(referencesObjType),
+			Metadata:   NewMetadata(&sr.SchemaMetadata{Sensitive: []string{"ssn"}}),
+		}
+		model.UpdateFromSchema(sr.SubjectSchema{
+			Schema: sr.Schema{Schema: `{"type":"string"}`, Type: sr.TypeAvro},
+		})
+		assert.Nil(t, model.Metadata)
+	})
+}
+
+func TestResourceModel_ToSchemaRequest_DataContract(t *testing.T) {
+	ruleSet, diags := NewRuleSet(&sr.SchemaRuleSet{
+		DomainRules: []sr.SchemaRule{{Name: "r", Kind: sr.SchemaRuleKindCondition, Mode: sr.SchemaRuleModeRead, Type: "CEL", Expr: "true"}},
+	})
+	require.False(t, diags.HasError())
+
+	model := &ResourceModel{
+		Schema:     types.StringValue(`{"type":"string"}`),
+		SchemaType: types.StringValue("AVRO"),
+		References: types.ListNull(referencesObjType),
+		Metadata:   NewMetadata(&sr.SchemaMetadata{Tags: map[string][]string{"f": {"PII"}}}),
+		RuleSet:    ruleSet,
+	}
+
+	req := model.ToSchemaRequest()
+	require.NotNil(t, req.SchemaMetadata)
+	assert.Equal(t, []string{"PII"}, req.SchemaMetadata.Tags["f"])
+	require.NotNil(t, req.SchemaRuleSet)
+	assert.Equal(t, sr.SchemaRuleModeRead, req.SchemaRuleSet.DomainRules[0].Mode)
+	assert.Nil(t, req.SchemaRuleSet.MigrationRules)
+}
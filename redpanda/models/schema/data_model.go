@@ -17,18 +17,23 @@
 package schema
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 )
 
 // DataModel represents schema datasource schema.
 type DataModel struct {
 	Subject    types.String `tfsdk:"subject"`
+	Context    types.String `tfsdk:"context"`
 	Schema     types.String `tfsdk:"schema"`
 	SchemaType types.String `tfsdk:"schema_type"`
 	Version    types.Int64  `tfsdk:"version"`
 	ID         types.Int64  `tfsdk:"id"`
 	ClusterID  types.String `tfsdk:"cluster_id"`
 	References types.List   `tfsdk:"references"`
+	Metadata   *Metadata    `tfsdk:"metadata"`
+	RuleSet    *RuleSet     `tfsdk:"rule_set"`
 	Username   types.String `tfsdk:"username"`
 	Password   types.String `tfsdk:"password"`
 }
@@ -38,6 +43,21 @@ func (d *DataModel) GetID() string {
 	return d.ID.String()
 }
 
+// GetSubject returns the subject name, qualified with its context when one is
+// set.
+func (d *DataModel) GetSubject() string {
+	return utils.QualifySubject(d.Context.ValueString(), d.Subject.ValueString())
+}
+
+// ReferenceObjectType is the object type of a single schema reference.
+var ReferenceObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":    types.StringType,
+		"subject": types.StringType,
+		"version": types.Int64Type,
+	},
+}
+
 // Reference represents a reference to another schema.
 type Reference struct {
 	Name    types.String `tfsdk:"name"`
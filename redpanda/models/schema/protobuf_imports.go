@@ -0,0 +1,60 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schema
+
+import (
+	"strings"
+
+	"github.com/emicklei/proto"
+)
+
+// builtinProtobufImportPrefixes are import paths Schema Registry resolves on
+// its own; they are never registered as subjects.
+var builtinProtobufImportPrefixes = []string{
+	"google/protobuf/",
+	"google/type/",
+	"confluent/",
+}
+
+// ProtobufImports returns the import paths declared by a PROTOBUF schema body,
+// in declaration order, skipping the well-known imports Schema Registry
+// bundles.
+func ProtobufImports(src string) ([]string, error) {
+	def, err := proto.NewParser(strings.NewReader(src)).Parse()
+	if err != nil {
+		return nil, err
+	}
+	var imports []string
+	for _, e := range def.Elements {
+		imp, ok := e.(*proto.Import)
+		if !ok || IsBuiltinProtobufImport(imp.Filename) {
+			continue
+		}
+		imports = append(imports, imp.Filename)
+	}
+	return imports, nil
+}
+
+// IsBuiltinProtobufImport reports whether the import path is provided by
+// Schema Registry itself.
+func IsBuiltinProtobufImport(path string) bool {
+	for _, prefix := range builtinProtobufImportPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtobufImports(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "no imports",
+			src:  `syntax = "proto3"; package p; message M { string a = 1; }`,
+		},
+		{
+			name: "local imports in declaration order",
+			src: `syntax = "proto3";
+package orders.v1;
+
+import "common/money.proto";
+import public "orders/v1/customer.proto";
+
+message Order { common.Money total = 1; Customer customer = 2; }
+`,
+			want: []string{"common/money.proto", "orders/v1/customer.proto"},
+		},
+		{
+			name: "well-known imports skipped",
+			src: `syntax = "proto3";
+package p;
+
+import "google/protobuf/timestamp.proto";
+import "google/type/date.proto";
+import "shared/id.proto";
+
+message M { google.protobuf.Timestamp at = 1; }
+`,
+			want: []string{"shared/id.proto"},
+		},
+		{
+			name:    "unparsable body",
+			src:     `syntax = "proto3"; message {`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProtobufImports(tt.src)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
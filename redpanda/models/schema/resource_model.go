@@ -28,21 +28,36 @@ import (
 	"github.com/twmb/franz-go/pkg/sr"
 )
 
+// Values accepted by the delete_mode attribute.
+const (
+	DeleteModeSoft = "soft"
+	DeleteModeHard = "hard"
+)
+
 // ResourceModel represents the Terraform schema for the schema resource.
 type ResourceModel struct {
-	AllowDeletion     types.Bool   `tfsdk:"allow_deletion"`
-	ClusterID         types.String `tfsdk:"cluster_id"`
-	Compatibility     types.String `tfsdk:"compatibility"`
-	ID                types.Int64  `tfsdk:"id"`
-	Password          types.String `tfsdk:"password"`
-	PasswordWO        types.String `tfsdk:"password_wo"`
-	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
-	References        types.List   `tfsdk:"references"`
-	Schema            types.String `tfsdk:"schema"`
-	SchemaType        types.String `tfsdk:"schema_type"`
-	Subject           types.String `tfsdk:"subject"`
-	Username          types.String `tfsdk:"username"`
-	Version           types.Int64  `tfsdk:"version"`
+	AllowDeletion      types.Bool   `tfsdk:"allow_deletion"`
+	AuthMode           types.String `tfsdk:"auth_mode"`
+	ClusterID          types.String `tfsdk:"cluster_id"`
+	Compatibility      types.String `tfsdk:"compatibility"`
+	Context            types.String `tfsdk:"context"`
+	DeleteMode         types.String `tfsdk:"delete_mode"`
+	ID                 types.Int64  `tfsdk:"id"`
+	KeepVersions       types.Int64  `tfsdk:"keep_versions"`
+	Metadata           *Metadata    `tfsdk:"metadata"`
+	Password           types.String `tfsdk:"password"`
+	PasswordWO         types.String `tfsdk:"password_wo"`
+	PasswordWOVersion  types.Int64  `tfsdk:"password_wo_version"`
+	ProtoDependencies  types.List   `tfsdk:"proto_dependencies"`
+	ProtoSourceDir     types.String `tfsdk:"proto_source_dir"`
+	References         types.List   `tfsdk:"references"`
+	ResolvedReferences types.List   `tfsdk:"resolved_references"`
+	RuleSet            *RuleSet     `tfsdk:"rule_set"`
+	Schema             types.String `tfsdk:"schema"`
+	SchemaType         types.String `tfsdk:"schema_type"`
+	Subject            types.String `tfsdk:"subject"`
+	Username           types.String `tfsdk:"username"`
+	Version            types.Int64  `tfsdk:"version"`
 }
 
 // GetEffectivePassword returns the password to use, preferring password_wo over password.
@@ -50,14 +65,26 @@ func (r *ResourceModel) GetEffectivePassword() string {
 	return utils.GetEffectivePassword(r.Password, r.PasswordWO)
 }
 
+// GetCredentials returns the username and password for the Schema Registry
+// client, honouring auth_mode.
+func (r *ResourceModel) GetCredentials() (username, password string) {
+	return utils.SchemaRegistryCredentials(r.AuthMode, r.Username.ValueString(), r.GetEffectivePassword())
+}
+
+// IsHardDelete reports whether deleted versions are permanently removed.
+func (r *ResourceModel) IsHardDelete() bool {
+	return r.DeleteMode.ValueString() == DeleteModeHard
+}
+
 // GetID returns the schema ID.
 func (r *ResourceModel) GetID() string {
 	return r.ID.String()
 }
 
-// GetSubject returns the subject name
+// GetSubject returns the subject name, qualified with its context when one is
+// set.
 func (r *ResourceModel) GetSubject() string {
-	return r.Subject.ValueString()
+	return utils.QualifySubject(r.Context.ValueString(), r.Subject.ValueString())
 }
 
 // GetVersion returns the version as an int pointer (nil if not set)
@@ -81,8 +108,29 @@ func (r *ResourceModel) UpdateFromSchema(schemaResp sr.SubjectSchema) diag.Diagn
 	}
 
 	r.SchemaType = types.StringValue(strings.ToUpper(schemaResp.Type.String()))
-	refsList, diags := r.convertReferencesToTerraform(schemaResp.References, r.References)
-	r.References = refsList
+	var diags diag.Diagnostics
+	// In proto_source_dir mode the references are resolved by the provider
+	// and never configured, so the registry's copy only feeds
+	// resolved_references.
+	if !r.UsesProtoSources() {
+		refsList, d := r.convertReferencesToTerraform(schemaResp.References, r.References)
+		diags.Append(d...)
+		r.References = refsList
+	}
+	resolved, d := r.convertReferencesToTerraform(schemaResp.References, r.ResolvedReferences)
+	diags.Append(d...)
+	r.ResolvedReferences = resolved
+
+	// Keep the user's metadata and rules when the registry's copy says the
+	// same thing, so configured-but-empty collections don't churn to null.
+	if !MetadataEquivalent(r.Metadata.ToSR(), schemaResp.SchemaMetadata) {
+		r.Metadata = NewMetadata(schemaResp.SchemaMetadata)
+	}
+	if !RuleSetEquivalent(r.RuleSet.ToSR(), schemaResp.SchemaRuleSet) {
+		ruleSet, d := NewRuleSet(schemaResp.SchemaRuleSet)
+		diags.Append(d...)
+		r.RuleSet = ruleSet
+	}
 	return diags
 }
 
@@ -91,15 +139,9 @@ func (r *ResourceModel) UpdateFromSchema(schemaResp sr.SubjectSchema) diag.Diagn
 // is preserved: a configured empty list stays empty, an omitted (null) block
 // stays null. Coercing []->null trips Terraform's post-apply consistency check
 // (references is Optional, not Computed).
-func (*ResourceModel) convertReferencesToTerraform(refs []sr.SchemaReference, prior types.List) (types.List, diag.Diagnostics) {
+func (r *ResourceModel) convertReferencesToTerraform(refs []sr.SchemaReference, prior types.List) (types.List, diag.Diagnostics) {
 	var diags diag.Diagnostics
-	objType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"name":    types.StringType,
-			"subject": types.StringType,
-			"version": types.Int64Type,
-		},
-	}
+	objType := ReferenceObjectType
 	if len(refs) == 0 {
 		if !prior.IsNull() && !prior.IsUnknown() {
 			return types.ListValueMust(objType, []attr.Value{}), diags
@@ -107,13 +149,20 @@ func (*ResourceModel) convertReferencesToTerraform(refs []sr.SchemaReference, pr
 		return types.ListNull(objType), diags
 	}
 
+	priorRefs := parseSchemaReferences(prior)
 	elems := make([]attr.Value, 0, len(refs))
-	for _, ref := range refs {
+	for i, ref := range refs {
+		// The registry may report a reference within the schema's own context
+		// in either bare or qualified form; keep the configured spelling.
+		subject := ref.Subject
+		if i < len(priorRefs) && utils.SameSubject(r.Context.ValueString(), priorRefs[i].Subject, subject) {
+			subject = priorRefs[i].Subject
+		}
 		refObj, d := types.ObjectValue(
 			objType.AttrTypes,
 			map[string]attr.Value{
 				"name":    types.StringValue(ref.Name),
-				"subject": types.StringValue(ref.Subject),
+				"subject": types.StringValue(subject),
 				"version": types.Int64Value(int64(ref.Version)),
 			},
 		)
@@ -126,12 +175,124 @@ func (*ResourceModel) convertReferencesToTerraform(refs []sr.SchemaReference, pr
 	return refsList, diags
 }
 
+// UsesProtoSources reports whether references are resolved from the imports
+// of local .proto files rather than configured by hand.
+func (r *ResourceModel) UsesProtoSources() bool {
+	return !r.ProtoSourceDir.IsNull() && !r.ProtoSourceDir.IsUnknown() && r.ProtoSourceDir.ValueString() != ""
+}
+
+// SetResolvedReferences records the references resolved from local .proto
+// imports.
+func (r *ResourceModel) SetResolvedReferences(refs []sr.SchemaReference) diag.Diagnostics {
+	resolved, diags := r.convertReferencesToTerraform(refs, types.ListNull(ReferenceObjectType))
+	r.ResolvedReferences = resolved
+	return diags
+}
+
+// ProtoDependency is an imported .proto file resolved from proto_source_dir
+// and the dependent subject it is registered under.
+type ProtoDependency struct {
+	Name    string
+	Subject string
+	Version int
+	// SHA256 is the hex digest of the file content the subject was resolved
+	// from.
+	SHA256 string
+	// Registered reports whether the subject was created by this resource,
+	// and is therefore deleted with it.
+	Registered bool
+}
+
+// ProtoDependencyObjectType is the object type of a single proto_dependencies
+// entry.
+var ProtoDependencyObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":       types.StringType,
+		"subject":    types.StringType,
+		"version":    types.Int64Type,
+		"sha256":     types.StringType,
+		"registered": types.BoolType,
+	},
+}
+
+// SetProtoDependencies records the imported files resolved from
+// proto_source_dir. Outside proto_source_dir mode the list is null.
+func (r *ResourceModel) SetProtoDependencies(deps []ProtoDependency) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !r.UsesProtoSources() {
+		r.ProtoDependencies = types.ListNull(ProtoDependencyObjectType)
+		return diags
+	}
+	elems := make([]attr.Value, 0, len(deps))
+	for _, dep := range deps {
+		obj, d := types.ObjectValue(ProtoDependencyObjectType.AttrTypes, map[string]attr.Value{
+			"name":       types.StringValue(dep.Name),
+			"subject":    types.StringValue(dep.Subject),
+			"version":    types.Int64Value(int64(dep.Version)),
+			"sha256":     types.StringValue(dep.SHA256),
+			"registered": types.BoolValue(dep.Registered),
+		})
+		diags.Append(d...)
+		elems = append(elems, obj)
+	}
+	list, d := types.ListValue(ProtoDependencyObjectType, elems)
+	diags.Append(d...)
+	r.ProtoDependencies = list
+	return diags
+}
+
+// GetProtoDependencies returns the recorded proto_dependencies, in resolution
+// order: every file comes after the files it imports.
+func (r *ResourceModel) GetProtoDependencies() []ProtoDependency {
+	return ParseProtoDependencies(r.ProtoDependencies)
+}
+
+// ParseProtoDependencies returns the entries of a proto_dependencies list read
+// from a plan or state.
+func ParseProtoDependencies(list types.List) []ProtoDependency {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	deps := make([]ProtoDependency, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		var dep ProtoDependency
+		if v, ok := attrs["name"].(types.String); ok {
+			dep.Name = v.ValueString()
+		}
+		if v, ok := attrs["subject"].(types.String); ok {
+			dep.Subject = v.ValueString()
+		}
+		if v, ok := attrs["version"].(types.Int64); ok {
+			dep.Version = int(v.ValueInt64())
+		}
+		if v, ok := attrs["sha256"].(types.String); ok {
+			dep.SHA256 = v.ValueString()
+		}
+		if v, ok := attrs["registered"].(types.Bool); ok {
+			dep.Registered = v.ValueBool()
+		}
+		deps = append(deps, dep)
+	}
+	return deps
+}
+
 // ToSchemaRequest converts the ResourceModel to a sr.Schema for API requests
 func (r *ResourceModel) ToSchemaRequest() sr.Schema {
+	refs := r.References
+	if r.UsesProtoSources() {
+		refs = r.ResolvedReferences
+	}
 	return sr.Schema{
-		Schema:     r.Schema.ValueString(),
-		Type:       r.convertSchemaType(),
-		References: r.parseSchemaReferences(),
+		Schema:         r.Schema.ValueString(),
+		Type:           r.convertSchemaType(),
+		References:     parseSchemaReferences(refs),
+		SchemaMetadata: r.Metadata.ToSR(),
+		SchemaRuleSet:  r.RuleSet.ToSR(),
 	}
 }
 
@@ -154,12 +315,12 @@ func (r *ResourceModel) convertSchemaType() sr.SchemaType {
 }
 
 // parseSchemaReferences parses the references from Terraform types to sr.SchemaReference
-func (r *ResourceModel) parseSchemaReferences() []sr.SchemaReference {
-	if r.References.IsNull() || r.References.IsUnknown() {
+func parseSchemaReferences(list types.List) []sr.SchemaReference {
+	if list.IsNull() || list.IsUnknown() {
 		return nil
 	}
 
-	elements := r.References.Elements()
+	elements := list.Elements()
 	references := make([]sr.SchemaReference, 0, len(elements))
 
 	for _, elem := range elements {
@@ -58,6 +58,52 @@ func TestResourceModel_UpdateFromSchema_EmptyReferencesPreservesShape(t *testing
 	})
 }
 
+func TestResourceModel_GetSubject_Context(t *testing.T) {
+	model := &ResourceModel{Subject: types.StringValue("orders-value")}
+	assert.Equal(t, "orders-value", model.GetSubject())
+
+	model.Context = types.StringValue("tenant-a")
+	assert.Equal(t, ":.tenant-a:orders-value", model.GetSubject())
+}
+
+// A reference into the schema's own context may come back from the registry
+// qualified even though it was configured bare; that must not show as drift.
+func TestResourceModel_UpdateFromSchema_ContextReferencesKeepConfiguredForm(t *testing.T) {
+	configured := types.ListValueMust(referencesObjType, []attr.Value{
+		types.ObjectValueMust(referencesObjType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("common.avsc"),
+			"subject": types.StringValue("common"),
+			"version": types.Int64Value(1),
+		}),
+		types.ObjectValueMust(referencesObjType.AttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("shared.avsc"),
+			"subject": types.StringValue(":.shared:common"),
+			"version": types.Int64Value(2),
+		}),
+	})
+	model := &ResourceModel{
+		Context:    types.StringValue("tenant-a"),
+		Schema:     types.StringValue(`{"type":"string"}`),
+		SchemaType: types.StringValue("AVRO"),
+		References: configured,
+	}
+	model.UpdateFromSchema(sr.SubjectSchema{
+		Schema: sr.Schema{
+			Schema: `{"type":"string"}`,
+			Type:   sr.TypeAvro,
+			References: []sr.SchemaReference{
+				{Name: "common.avsc", Subject: ":.tenant-a:common", Version: 1},
+				{Name: "shared.avsc", Subject: ":.shared:common", Version: 2},
+			},
+		},
+	})
+
+	assert.Equal(t, []sr.SchemaReference{
+		{Name: "common.avsc", Subject: "common", Version: 1},
+		{Name: "shared.avsc", Subject: ":.shared:common", Version: 2},
+	}, parseSchemaReferences(model.References))
+}
+
 // Schema Registry canonicalizes protobuf bodies on write (reorders enums
 // before messages, fully-qualifies in-package type references). The user's
 // planned form must be preserved when the only difference is that
@@ -471,3 +517,19 @@ func TestResourceModel_ToSchemaRequest_Equivalence(t *testing.T) {
 	assert.Equal(t, req1.Type, req2.Type)
 	assert.Equal(t, sr.TypeAvro, req1.Type)
 }
+
+func TestResourceModel_GetCredentials(t *testing.T) {
+	model := &ResourceModel{
+		Username:   types.StringValue("alice"),
+		Password:   types.StringValue("secret"),
+		PasswordWO: types.StringNull(),
+	}
+	user, pass := model.GetCredentials()
+	assert.Equal(t, "alice", user)
+	assert.Equal(t, "secret", pass)
+
+	model.AuthMode = types.StringValue("provider_token")
+	user, pass = model.GetCredentials()
+	assert.Empty(t, user, "provider_token mode never sends SASL credentials")
+	assert.Empty(t, pass)
+}
@@ -26,7 +26,9 @@ import (
 // ResourceModel represents the Terraform schema for the schemaregistryacl resource.
 type ResourceModel struct {
 	AllowDeletion     types.Bool   `tfsdk:"allow_deletion"`
+	AuthMode          types.String `tfsdk:"auth_mode"`
 	ClusterID         types.String `tfsdk:"cluster_id"`
+	Context           types.String `tfsdk:"context"`
 	Host              types.String `tfsdk:"host"`
 	ID                types.String `tfsdk:"id"`
 	Operation         types.String `tfsdk:"operation"`
@@ -46,11 +48,26 @@ func (s *ResourceModel) GetEffectivePassword() string {
 	return utils.GetEffectivePassword(s.Password, s.PasswordWO)
 }
 
+// GetCredentials returns the username and password for the Schema Registry
+// client, honouring auth_mode.
+func (s *ResourceModel) GetCredentials() (username, password string) {
+	return utils.SchemaRegistryCredentials(s.AuthMode, s.Username.ValueString(), s.GetEffectivePassword())
+}
+
+// GetResourceName returns the resource name as sent to Schema Registry: SUBJECT
+// names are qualified with the ACL's context when one is set.
+func (s *ResourceModel) GetResourceName() string {
+	if s.ResourceType.ValueString() != "SUBJECT" {
+		return s.ResourceName.ValueString()
+	}
+	return utils.QualifySubject(s.Context.ValueString(), s.ResourceName.ValueString())
+}
+
 // ToSchemaRegistryACLRequest converts the model to a SchemaRegistryACLRequest for API calls
 func (s *ResourceModel) ToSchemaRegistryACLRequest() kclients.SchemaRegistryACLRequest {
 	return kclients.SchemaRegistryACLRequest{
 		Principal:    s.Principal.ValueString(),
-		Resource:     s.ResourceName.ValueString(),
+		Resource:     s.GetResourceName(),
 		ResourceType: s.ResourceType.ValueString(),
 		PatternType:  s.PatternType.ValueString(),
 		Host:         s.Host.ValueString(),
@@ -63,7 +80,7 @@ func (s *ResourceModel) ToSchemaRegistryACLRequest() kclients.SchemaRegistryACLR
 func (s *ResourceModel) ToSchemaRegistryACLFilter() kclients.SchemaRegistryACLFilter {
 	return kclients.SchemaRegistryACLFilter{
 		Principal:    s.Principal.ValueString(),
-		Resource:     s.ResourceName.ValueString(),
+		Resource:     s.GetResourceName(),
 		ResourceType: s.ResourceType.ValueString(),
 		PatternType:  s.PatternType.ValueString(),
 		Host:         s.Host.ValueString(),
@@ -78,7 +95,7 @@ func (s *ResourceModel) GenerateID() string {
 		s.ClusterID.ValueString(),
 		s.Principal.ValueString(),
 		s.ResourceType.ValueString(),
-		s.ResourceName.ValueString(),
+		s.GetResourceName(),
 		s.PatternType.ValueString(),
 		s.Host.ValueString(),
 		s.Operation.ValueString(),
@@ -88,7 +105,7 @@ func (s *ResourceModel) GenerateID() string {
 // MatchesACLResponse checks if the model matches the given ACL response
 func (s *ResourceModel) MatchesACLResponse(acl *kclients.SchemaRegistryACLResponse) bool {
 	return acl.Principal == s.Principal.ValueString() &&
-		acl.Resource == s.ResourceName.ValueString() &&
+		acl.Resource == s.GetResourceName() &&
 		acl.ResourceType == s.ResourceType.ValueString() &&
 		acl.PatternType == s.PatternType.ValueString() &&
 		acl.Host == s.Host.ValueString() &&
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/kclients"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -101,3 +102,30 @@ func TestResourceModel_GenerateID(t *testing.T) {
 	expected := "cluster-1:User:alice:SUBJECT:test-subject:LITERAL:*:READ:ALLOW"
 	assert.Equal(t, expected, acl.GenerateID())
 }
+
+func TestResourceModel_Context(t *testing.T) {
+	acl := ResourceModel{
+		ClusterID:    types.StringValue("cluster-1"),
+		Principal:    types.StringValue("User:alice"),
+		ResourceType: types.StringValue("SUBJECT"),
+		ResourceName: types.StringValue("orders-"),
+		Context:      types.StringValue("tenant-a"),
+		PatternType:  types.StringValue("PREFIXED"),
+		Host:         types.StringValue("*"),
+		Operation:    types.StringValue("READ"),
+		Permission:   types.StringValue("ALLOW"),
+	}
+
+	assert.Equal(t, ":.tenant-a:orders-", acl.ToSchemaRegistryACLRequest().Resource)
+	assert.Equal(t, ":.tenant-a:orders-", acl.ToSchemaRegistryACLFilter().Resource)
+	assert.Equal(t, "cluster-1:User:alice:SUBJECT::.tenant-a:orders-:PREFIXED:*:READ:ALLOW", acl.GenerateID())
+	assert.True(t, acl.MatchesACLResponse(&kclients.SchemaRegistryACLResponse{
+		Principal:    "User:alice",
+		Resource:     ":.tenant-a:orders-",
+		ResourceType: "SUBJECT",
+		PatternType:  "PREFIXED",
+		Host:         "*",
+		Operation:    "READ",
+		Permission:   "ALLOW",
+	}))
+}
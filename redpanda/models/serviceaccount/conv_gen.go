@@ -57,6 +57,11 @@ func Flatten(ctx context.Context, proto ServiceAccountResponse, prev *ResourceMo
 	} else {
 		m.RoleBindings = types.ListNull(types.ObjectType{AttrTypes: RoleBindingsAttrTypes()})
 	}
+	if prev != nil && !prev.ClientSecretVersion.IsUnknown() {
+		m.ClientSecretVersion = prev.ClientSecretVersion
+	} else {
+		m.ClientSecretVersion = types.Int64Null()
+	}
 	if prev != nil {
 		m.Timeouts = prev.Timeouts
 	}
@@ -0,0 +1,39 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package serviceaccount
+
+import (
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CredentialsModel represents the Terraform model for the
+// redpanda_service_account_credentials ephemeral resource.
+type CredentialsModel struct {
+	ServiceAccountID types.String `tfsdk:"service_account_id"`
+	ClientID         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+}
+
+// FlattenCredentials returns a CredentialsModel for the given service account
+// and credentials.
+func FlattenCredentials(serviceAccountID string, creds *iamv1.ServiceAccountCredentials) *CredentialsModel {
+	return &CredentialsModel{
+		ServiceAccountID: types.StringValue(serviceAccountID),
+		ClientID:         types.StringValue(creds.GetClientId()),
+		ClientSecret:     types.StringValue(creds.GetClientSecret()),
+	}
+}
@@ -30,6 +30,7 @@ import (
 // ResourceModel represents the Terraform schema for the serviceaccount resource.
 type ResourceModel struct {
 	Auth0ClientCredentials types.Object   `tfsdk:"auth0_client_credentials"`
+	ClientSecretVersion    types.Int64    `tfsdk:"client_secret_version"`
 	Description            types.String   `tfsdk:"description"`
 	ID                     types.String   `tfsdk:"id"`
 	Name                   types.String   `tfsdk:"name"`
@@ -170,6 +171,7 @@ func RoleBindingsScopeToObject(ctx context.Context, v *RoleBindingsScopeModel) (
 func GenerateMinimalResourceModel(id types.String, timeout timeouts.Value) *ResourceModel {
 	return &ResourceModel{
 		Auth0ClientCredentials: types.ObjectNull(Auth0ClientCredentialsAttrTypes()),
+		ClientSecretVersion:    types.Int64Null(),
 		Description:            types.StringNull(),
 		ID:                     id,
 		Name:                   types.StringNull(),
@@ -77,9 +77,27 @@ func Flatten(_ context.Context, proto TopicResponse, prev *ResourceModel) (*Reso
 	} else {
 		m.Configuration = types.MapNull(types.StringType)
 	}
+	if prev != nil && !prev.ConfigurationMode.IsUnknown() {
+		m.ConfigurationMode = prev.ConfigurationMode
+	} else {
+		m.ConfigurationMode = types.StringNull()
+	}
+	if prev != nil && !prev.Iceberg.IsUnknown() {
+		m.Iceberg = prev.Iceberg
+	} else {
+		m.Iceberg = types.ObjectNull(IcebergAttrTypes())
+	}
+	if prev != nil && !prev.TieredStorage.IsUnknown() {
+		m.TieredStorage = prev.TieredStorage
+	} else {
+		m.TieredStorage = types.ObjectNull(TieredStorageAttrTypes())
+	}
 	if m.AllowDeletion.IsNull() || m.AllowDeletion.IsUnknown() {
 		m.AllowDeletion = types.BoolValue(false)
 	}
+	if m.ConfigurationMode.IsNull() || m.ConfigurationMode.IsUnknown() {
+		m.ConfigurationMode = types.StringValue("additive")
+	}
 	return m, diags
 }
 
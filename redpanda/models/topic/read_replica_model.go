@@ -0,0 +1,28 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// ReadReplicaModel represents the Terraform schema for the read replica topic
+// resource.
+type ReadReplicaModel struct {
+	AllowDeletion   types.Bool   `tfsdk:"allow_deletion"`
+	ClusterAPIURL   types.String `tfsdk:"cluster_api_url"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	SourceBucket    types.String `tfsdk:"source_bucket"`
+	SourceClusterID types.String `tfsdk:"source_cluster_id"`
+}
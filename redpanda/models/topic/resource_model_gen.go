@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // ResourceModel represents the Terraform schema for the topic resource.
@@ -30,15 +31,27 @@ type ResourceModel struct {
 	AllowDeletion      types.Bool   `tfsdk:"allow_deletion"`
 	ClusterAPIURL      types.String `tfsdk:"cluster_api_url"`
 	Configuration      types.Map    `tfsdk:"configuration"`
+	ConfigurationMode  types.String `tfsdk:"configuration_mode"`
+	Iceberg            types.Object `tfsdk:"iceberg"`
 	ID                 types.String `tfsdk:"id"`
 	Name               types.String `tfsdk:"name"`
 	PartitionCount     types.Number `tfsdk:"partition_count"`
 	ReplicaAssignments types.List   `tfsdk:"replica_assignments"`
 	ReplicationFactor  types.Number `tfsdk:"replication_factor"`
+	TieredStorage      types.Object `tfsdk:"tiered_storage"`
 }
 
 // --- Nested typed structs (one per nested message in the proto tree) ---
 
+// IcebergModel mirrors the nested "iceberg" attribute. Use the As/To
+// converters on the parent struct to move between types.Object and this
+// typed form.
+type IcebergModel struct {
+	InvalidRecordAction types.String `tfsdk:"invalid_record_action"`
+	Mode                types.String `tfsdk:"mode"`
+	PartitionSpec       types.String `tfsdk:"partition_spec"`
+}
+
 // ReplicaAssignmentsModel mirrors the nested "replica_assignments" attribute. Use the As/To
 // converters on the parent struct to move between types.Object and this
 // typed form.
@@ -47,8 +60,28 @@ type ReplicaAssignmentsModel struct {
 	ReplicaIds  types.List  `tfsdk:"replica_ids"`
 }
 
+// TieredStorageModel mirrors the nested "tiered_storage" attribute. Use the As/To
+// converters on the parent struct to move between types.Object and this
+// typed form.
+type TieredStorageModel struct {
+	LocalRetentionBytes types.String `tfsdk:"local_retention_bytes"`
+	LocalRetentionMs    types.String `tfsdk:"local_retention_ms"`
+	RemoteRead          types.Bool   `tfsdk:"remote_read"`
+	RemoteWrite         types.Bool   `tfsdk:"remote_write"`
+}
+
 // --- AttrType tables for nested types (consumed by types.ObjectValueFrom / ObjectNull) ---
 
+// IcebergAttrTypes returns the attr.Type map for the "iceberg" nested
+// attribute.
+func IcebergAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"invalid_record_action": types.StringType,
+		"mode":                  types.StringType,
+		"partition_spec":        types.StringType,
+	}
+}
+
 // ReplicaAssignmentsAttrTypes returns the attr.Type map for the "replica_assignments" nested
 // attribute.
 func ReplicaAssignmentsAttrTypes() map[string]attr.Type {
@@ -58,8 +91,42 @@ func ReplicaAssignmentsAttrTypes() map[string]attr.Type {
 	}
 }
 
+// TieredStorageAttrTypes returns the attr.Type map for the "tiered_storage" nested
+// attribute.
+func TieredStorageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"local_retention_bytes": types.StringType,
+		"local_retention_ms":    types.StringType,
+		"remote_read":           types.BoolType,
+		"remote_write":          types.BoolType,
+	}
+}
+
 // --- Root-level converters (types.Object ⇄ typed struct ergonomics) ---
 
+// AsIceberg converts the root iceberg attribute from
+// types.Object into its typed form. Returns (nil, nil) when the object is
+// null or unknown. Use this when you want typed field access without
+// manually unpacking .Attributes().
+func (m *ResourceModel) AsIceberg(ctx context.Context) (*IcebergModel, diag.Diagnostics) {
+	if m == nil || m.Iceberg.IsNull() || m.Iceberg.IsUnknown() {
+		return nil, nil
+	}
+	var out IcebergModel
+	d := m.Iceberg.As(ctx, &out, basetypes.ObjectAsOptions{})
+	return &out, d
+}
+
+// IcebergToObject encodes a typed struct back into the
+// types.Object shape expected by the framework. A nil receiver returns
+// types.ObjectNull with the correct attribute types.
+func IcebergToObject(ctx context.Context, v *IcebergModel) (types.Object, diag.Diagnostics) {
+	if v == nil {
+		return types.ObjectNull(IcebergAttrTypes()), nil
+	}
+	return types.ObjectValueFrom(ctx, IcebergAttrTypes(), v)
+}
+
 // AsReplicaAssignments decodes the root replica_assignments list attribute into
 // a typed slice. Returns (nil, nil) when null or unknown.
 func (m *ResourceModel) AsReplicaAssignments(ctx context.Context) ([]ReplicaAssignmentsModel, diag.Diagnostics) {
@@ -82,6 +149,29 @@ func ReplicaAssignmentsToList(ctx context.Context, v []ReplicaAssignmentsModel)
 	return types.ListValueFrom(ctx, elemType, v)
 }
 
+// AsTieredStorage converts the root tiered_storage attribute from
+// types.Object into its typed form. Returns (nil, nil) when the object is
+// null or unknown. Use this when you want typed field access without
+// manually unpacking .Attributes().
+func (m *ResourceModel) AsTieredStorage(ctx context.Context) (*TieredStorageModel, diag.Diagnostics) {
+	if m == nil || m.TieredStorage.IsNull() || m.TieredStorage.IsUnknown() {
+		return nil, nil
+	}
+	var out TieredStorageModel
+	d := m.TieredStorage.As(ctx, &out, basetypes.ObjectAsOptions{})
+	return &out, d
+}
+
+// TieredStorageToObject encodes a typed struct back into the
+// types.Object shape expected by the framework. A nil receiver returns
+// types.ObjectNull with the correct attribute types.
+func TieredStorageToObject(ctx context.Context, v *TieredStorageModel) (types.Object, diag.Diagnostics) {
+	if v == nil {
+		return types.ObjectNull(TieredStorageAttrTypes()), nil
+	}
+	return types.ObjectValueFrom(ctx, TieredStorageAttrTypes(), v)
+}
+
 // GenerateMinimalResourceModel returns a *ResourceModel populated only with
 // the supplied id; every other field is at its typed null (or its
 // declared minimal_default). Used by resources that need to persist a
@@ -91,10 +181,13 @@ func GenerateMinimalResourceModel(id types.String) *ResourceModel {
 		AllowDeletion:      types.BoolNull(),
 		ClusterAPIURL:      types.StringNull(),
 		Configuration:      types.MapNull(types.StringType),
+		ConfigurationMode:  types.StringNull(),
+		Iceberg:            types.ObjectNull(IcebergAttrTypes()),
 		ID:                 id,
 		Name:               types.StringNull(),
 		PartitionCount:     types.NumberNull(),
 		ReplicaAssignments: types.ListNull(types.ObjectType{AttrTypes: ReplicaAssignmentsAttrTypes()}),
 		ReplicationFactor:  types.NumberNull(),
+		TieredStorage:      types.ObjectNull(TieredStorageAttrTypes()),
 	}
 }
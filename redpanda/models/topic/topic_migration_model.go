@@ -0,0 +1,40 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MigrationModel represents the Terraform schema for the topic migration
+// resource.
+type MigrationModel struct {
+	ClusterAPIURL     types.String   `tfsdk:"cluster_api_url"`
+	Completed         types.Bool     `tfsdk:"completed"`
+	Configuration     types.Map      `tfsdk:"configuration"`
+	ConsumerGroup     types.String   `tfsdk:"consumer_group"`
+	ID                types.String   `tfsdk:"id"`
+	IdleTimeout       types.String   `tfsdk:"idle_timeout"`
+	PartitionCount    types.Int32    `tfsdk:"partition_count"`
+	PasswordSecret    types.String   `tfsdk:"password_secret"`
+	PipelineID        types.String   `tfsdk:"pipeline_id"`
+	ReplicationFactor types.Int32    `tfsdk:"replication_factor"`
+	SASLMechanism     types.String   `tfsdk:"sasl_mechanism"`
+	SourceTopic       types.String   `tfsdk:"source_topic"`
+	TargetTopic       types.String   `tfsdk:"target_topic"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	Username          types.String   `tfsdk:"username"`
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SetModel represents the Terraform schema for the topic set resource.
+type SetModel struct {
+	AllowDeletion types.Bool   `tfsdk:"allow_deletion"`
+	ClusterAPIURL types.String `tfsdk:"cluster_api_url"`
+	Defaults      types.Object `tfsdk:"defaults"`
+	ID            types.String `tfsdk:"id"`
+	Parallelism   types.Int32  `tfsdk:"parallelism"`
+	Topics        types.Map    `tfsdk:"topics"`
+}
+
+// SetTopicModel represents one entry of "topics", and "defaults", of the
+// topic set resource.
+type SetTopicModel struct {
+	Configuration     types.Map   `tfsdk:"configuration"`
+	PartitionCount    types.Int32 `tfsdk:"partition_count"`
+	ReplicationFactor types.Int32 `tfsdk:"replication_factor"`
+}
+
+// SetTopicAttrTypes returns the attr.Type map shared by the "topics" entries
+// and "defaults" of the topic set resource.
+func SetTopicAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"configuration":      types.MapType{ElemType: types.StringType},
+		"partition_count":    types.Int32Type,
+		"replication_factor": types.Int32Type,
+	}
+}
@@ -67,6 +67,21 @@ func Flatten(_ context.Context, proto UserResponse, prev *ResourceModel) (*Resou
 	} else {
 		m.PasswordWOVersion = types.Int64Null()
 	}
+	if prev != nil && !prev.GeneratePassword.IsUnknown() {
+		m.GeneratePassword = prev.GeneratePassword
+	} else {
+		m.GeneratePassword = types.ObjectNull(GeneratePasswordAttrTypes())
+	}
+	if prev != nil && !prev.RotateAfter.IsUnknown() {
+		m.RotateAfter = prev.RotateAfter
+	} else {
+		m.RotateAfter = types.StringNull()
+	}
+	if prev != nil && !prev.PasswordGeneratedAt.IsUnknown() {
+		m.PasswordGeneratedAt = prev.PasswordGeneratedAt
+	} else {
+		m.PasswordGeneratedAt = types.StringNull()
+	}
 	if prev != nil && !prev.AllowDeletion.IsUnknown() {
 		m.AllowDeletion = prev.AllowDeletion
 	} else {
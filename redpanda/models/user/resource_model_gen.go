@@ -19,19 +19,74 @@
 package user
 
 import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // ResourceModel represents the Terraform schema for the user resource.
 type ResourceModel struct {
-	AllowDeletion     types.Bool   `tfsdk:"allow_deletion"`
-	ClusterAPIURL     types.String `tfsdk:"cluster_api_url"`
-	ID                types.String `tfsdk:"id"`
-	Mechanism         types.String `tfsdk:"mechanism"`
-	Name              types.String `tfsdk:"name"`
-	Password          types.String `tfsdk:"password"`
-	PasswordWO        types.String `tfsdk:"password_wo"`
-	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	AllowDeletion       types.Bool   `tfsdk:"allow_deletion"`
+	ClusterAPIURL       types.String `tfsdk:"cluster_api_url"`
+	GeneratePassword    types.Object `tfsdk:"generate_password"`
+	ID                  types.String `tfsdk:"id"`
+	Mechanism           types.String `tfsdk:"mechanism"`
+	Name                types.String `tfsdk:"name"`
+	Password            types.String `tfsdk:"password"`
+	PasswordGeneratedAt types.String `tfsdk:"password_generated_at"`
+	PasswordWO          types.String `tfsdk:"password_wo"`
+	PasswordWOVersion   types.Int64  `tfsdk:"password_wo_version"`
+	RotateAfter         types.String `tfsdk:"rotate_after"`
+}
+
+// --- Nested typed structs (one per nested message in the proto tree) ---
+
+// GeneratePasswordModel mirrors the nested "generate_password" attribute. Use the As/To
+// converters on the parent struct to move between types.Object and this
+// typed form.
+type GeneratePasswordModel struct {
+	Length     types.Int64  `tfsdk:"length"`
+	SecretName types.String `tfsdk:"secret_name"`
+	Special    types.Bool   `tfsdk:"special"`
+}
+
+// --- AttrType tables for nested types (consumed by types.ObjectValueFrom / ObjectNull) ---
+
+// GeneratePasswordAttrTypes returns the attr.Type map for the "generate_password" nested
+// attribute.
+func GeneratePasswordAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"length":      types.Int64Type,
+		"secret_name": types.StringType,
+		"special":     types.BoolType,
+	}
+}
+
+// --- Root-level converters (types.Object ⇄ typed struct ergonomics) ---
+
+// AsGeneratePassword converts the root generate_password attribute from
+// types.Object into its typed form. Returns (nil, nil) when the object is
+// null or unknown. Use this when you want typed field access without
+// manually unpacking .Attributes().
+func (m *ResourceModel) AsGeneratePassword(ctx context.Context) (*GeneratePasswordModel, diag.Diagnostics) {
+	if m == nil || m.GeneratePassword.IsNull() || m.GeneratePassword.IsUnknown() {
+		return nil, nil
+	}
+	var out GeneratePasswordModel
+	d := m.GeneratePassword.As(ctx, &out, basetypes.ObjectAsOptions{})
+	return &out, d
+}
+
+// GeneratePasswordToObject encodes a typed struct back into the
+// types.Object shape expected by the framework. A nil receiver returns
+// types.ObjectNull with the correct attribute types.
+func GeneratePasswordToObject(ctx context.Context, v *GeneratePasswordModel) (types.Object, diag.Diagnostics) {
+	if v == nil {
+		return types.ObjectNull(GeneratePasswordAttrTypes()), nil
+	}
+	return types.ObjectValueFrom(ctx, GeneratePasswordAttrTypes(), v)
 }
 
 // GenerateMinimalResourceModel returns a *ResourceModel populated only with
@@ -40,13 +95,16 @@ type ResourceModel struct {
 // partial state when Create / Read returns mid-flight.
 func GenerateMinimalResourceModel(id types.String) *ResourceModel {
 	return &ResourceModel{
-		AllowDeletion:     types.BoolNull(),
-		ClusterAPIURL:     types.StringNull(),
-		ID:                id,
-		Mechanism:         types.StringNull(),
-		Name:              types.StringNull(),
-		Password:          types.StringNull(),
-		PasswordWO:        types.StringNull(),
-		PasswordWOVersion: types.Int64Null(),
+		AllowDeletion:       types.BoolNull(),
+		ClusterAPIURL:       types.StringNull(),
+		GeneratePassword:    types.ObjectNull(GeneratePasswordAttrTypes()),
+		ID:                  id,
+		Mechanism:           types.StringNull(),
+		Name:                types.StringNull(),
+		Password:            types.StringNull(),
+		PasswordGeneratedAt: types.StringNull(),
+		PasswordWO:          types.StringNull(),
+		PasswordWOVersion:   types.Int64Null(),
+		RotateAfter:         types.StringNull(),
 	}
 }
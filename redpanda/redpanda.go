@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -38,13 +39,19 @@ import (
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/acl"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/cluster"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/effectivepermissions"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/group"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/network"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/organizationuser"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/pipeline"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/principalmapping"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/region"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/regions"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/resourcegroup"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/role"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/roleassignment"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/rolebinding"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/rolemembers"
 	schemaresource "github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/schema"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/schemaregistryacl"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/secret"
@@ -63,7 +70,10 @@ import (
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &Redpanda{}
+var (
+	_ provider.Provider                       = &Redpanda{}
+	_ provider.ProviderWithEphemeralResources = &Redpanda{}
+)
 
 // Redpanda represents the Redpanda Terraform provider.
 type Redpanda struct {
@@ -471,10 +481,12 @@ func (r *Redpanda) Configure(ctx context.Context, request provider.ConfigureRequ
 			r.dataplanePool = cloud.NewConnPool(creds.TokenSource, r.version, request.TerraformVersion)
 		}
 	}
+	cpClients := cloud.NewControlPlaneClientSet(r.conn)
 	response.ResourceData = config.Resource{
 		TokenSource:            creds.TokenSource,
 		ByocClient:             r.byoc,
 		ControlPlaneConnection: r.conn,
+		ControlPlaneClients:    cpClients,
 		DataplaneConnPool:      r.dataplanePool,
 		TerraformVersion:       request.TerraformVersion,
 		ProviderVersion:        r.version,
@@ -482,6 +494,15 @@ func (r *Redpanda) Configure(ctx context.Context, request provider.ConfigureRequ
 	response.DataSourceData = config.Datasource{
 		TokenSource:            creds.TokenSource,
 		ControlPlaneConnection: r.conn,
+		ControlPlaneClients:    cpClients,
+		DataplaneConnPool:      r.dataplanePool,
+		TerraformVersion:       request.TerraformVersion,
+		ProviderVersion:        r.version,
+	}
+	response.EphemeralResourceData = config.Datasource{
+		TokenSource:            creds.TokenSource,
+		ControlPlaneConnection: r.conn,
+		ControlPlaneClients:    cpClients,
 		DataplaneConnPool:      r.dataplanePool,
 		TerraformVersion:       request.TerraformVersion,
 		ProviderVersion:        r.version,
@@ -512,6 +533,10 @@ func (*Redpanda) DataSources(_ context.Context) []func() datasource.DataSource {
 		func() datasource.DataSource { return regions.NewDataSourceRegions() },
 		func() datasource.DataSource { return throughputtiers.NewDataSourceThroughputTiers() },
 		func() datasource.DataSource { return schemaresource.NewSchemaDataSource() },
+		func() datasource.DataSource { return rolebinding.NewDataSourceRoleBinding() },
+		func() datasource.DataSource { return organizationuser.NewDataSourceOrganizationUsers() },
+		func() datasource.DataSource { return group.NewDataSourceGroups() },
+		func() datasource.DataSource { return effectivepermissions.NewDataSourceEffectivePermissions() },
 	}
 }
 
@@ -528,13 +553,30 @@ func (*Redpanda) Resources(_ context.Context) []func() resource.Resource {
 		func() resource.Resource { return acl.NewACL() },
 		func() resource.Resource { return user.NewUser() },
 		func() resource.Resource { return topic.NewTopic() },
+		func() resource.Resource { return topic.NewReadReplicaTopic() },
+		func() resource.Resource { return topic.NewTopicSet() },
+		func() resource.Resource { return topic.NewTopicMigration() },
 		func() resource.Resource { return role.NewRole() },
 		func() resource.Resource { return roleassignment.NewRoleAssignment() },
+		func() resource.Resource { return rolemembers.NewRoleMembers() },
+		func() resource.Resource { return principalmapping.NewPrincipalMapping() },
 		func() resource.Resource { return schemaresource.NewSchema() },
 		func() resource.Resource { return schemaregistryacl.NewSchemaRegistryACL() },
 		func() resource.Resource { return pipeline.NewPipeline() },
 		func() resource.Resource { return secret.NewSecret() },
 		func() resource.Resource { return shadowlink.NewShadowLink() },
 		func() resource.Resource { return serviceaccount.NewServiceAccount() },
+		func() resource.Resource { return rolebinding.NewRoleBinding() },
+		func() resource.Resource { return organizationuser.NewOrganizationUser() },
+		func() resource.Resource { return group.NewGroup() },
+		func() resource.Resource { return group.NewGroupMembership() },
+	}
+}
+
+// EphemeralResources returns a slice of functions to instantiate each Redpanda
+// ephemeral resource.
+func (*Redpanda) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource { return serviceaccount.NewEphemeralServiceAccountCredentials() },
 	}
 }
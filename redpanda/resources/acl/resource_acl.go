@@ -105,20 +105,12 @@ func (a *ACL) Create(ctx context.Context, request resource.CreateRequest, respon
 		return
 	}
 
-	listFilter := buildACLFilter(&model)
 	probeACLExists := func() bool {
-		listResp, listErr := a.ACLClient.ListACLs(ctx, &dataplanev1.ListACLsRequest{Filter: listFilter})
+		listResp, listErr := a.ACLClient.ListACLs(ctx, utils.ListAllACLsRequest())
 		if listErr != nil {
 			return false
 		}
-		for _, res := range listResp.GetResources() {
-			if res.GetResourceName() == model.ResourceName.ValueString() &&
-				res.GetResourceType() == pbReq.GetResourceType() &&
-				res.GetResourcePatternType() == pbReq.GetResourcePatternType() {
-				return true
-			}
-		}
-		return false
+		return findACL(listResp.GetResources(), &model) != nil
 	}
 
 	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
@@ -151,8 +143,9 @@ func (a *ACL) Create(ctx context.Context, request resource.CreateRequest, respon
 	response.Diagnostics.Append(response.State.Set(ctx, &model)...)
 }
 
-// Read confirms the ACL exists in the cluster by listing matching records
-// against a filter built from the model. The matched ListACLsResponse_Resource
+// Read confirms the ACL exists in the cluster by listing every ACL and
+// matching the model against them client-side, so that all ACL resources of
+// a cluster share one ListACLs response during refresh. The matched ListACLsResponse_Resource
 // is fed to the generated Flatten, which keeps user-supplied identifying
 // fields (principal, host, operation, permission_type) preserved from prev
 // rather than echoed from the API.
@@ -174,11 +167,10 @@ func (a *ACL) Read(ctx context.Context, request resource.ReadRequest, response *
 		return
 	}
 
-	filter := buildACLFilter(&model)
 	var aclList *dataplanev1.ListACLsResponse
 	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
 		var rpcErr error
-		aclList, rpcErr = a.ACLClient.ListACLs(ctx, &dataplanev1.ListACLsRequest{Filter: filter})
+		aclList, rpcErr = a.ACLClient.ListACLs(ctx, utils.ListAllACLsRequest())
 		if rpcErr != nil {
 			if utils.IsUnavailable(rpcErr) {
 				return utils.RetryableError(rpcErr)
@@ -196,14 +188,7 @@ func (a *ACL) Read(ctx context.Context, request resource.ReadRequest, response *
 		return
 	}
 
-	wantType := enums.StringToACLResourceType(model.ResourceType.ValueString())
-	wantPattern := enums.StringToACLResourcePatternType(model.ResourcePatternType.ValueString())
-	for _, res := range aclList.Resources {
-		if res.ResourceName != model.ResourceName.ValueString() ||
-			res.ResourceType != wantType ||
-			res.ResourcePatternType != wantPattern {
-			continue
-		}
+	if res := findACL(aclList.GetResources(), &model); res != nil {
 		persist, diags := aclmodel.Flatten(ctx, res, &model)
 		response.Diagnostics.Append(diags...)
 		if response.Diagnostics.HasError() {
@@ -290,19 +275,29 @@ func (a *ACL) Delete(ctx context.Context, request resource.DeleteRequest, respon
 	}
 }
 
-// buildACLFilter constructs a ListACLsRequest_Filter populated from the
-// model, mirroring the same field-shape the generated ExpandDelete builds
-// for DeleteACLsRequest_Filter (separate proto types, same semantics).
-func buildACLFilter(m *aclmodel.ResourceModel) *dataplanev1.ListACLsRequest_Filter {
-	return &dataplanev1.ListACLsRequest_Filter{
-		ResourceType:        enums.StringToACLResourceType(m.ResourceType.ValueString()),
-		ResourceName:        utils.PointerOrNil(m.ResourceName, types.String.ValueString),
-		ResourcePatternType: enums.StringToACLResourcePatternType(m.ResourcePatternType.ValueString()),
-		Principal:           utils.PointerOrNil(m.Principal, types.String.ValueString),
-		Host:                utils.PointerOrNil(m.Host, types.String.ValueString),
-		Operation:           enums.StringToACLOperation(m.Operation.ValueString()),
-		PermissionType:      enums.StringToACLPermissionType(m.PermissionType.ValueString()),
+// findACL returns the listed resource holding the ACL described by the
+// model, or nil if the cluster has no such ACL.
+func findACL(resources []*dataplanev1.ListACLsResponse_Resource, m *aclmodel.ResourceModel) *dataplanev1.ListACLsResponse_Resource {
+	wantType := enums.StringToACLResourceType(m.ResourceType.ValueString())
+	wantPattern := enums.StringToACLResourcePatternType(m.ResourcePatternType.ValueString())
+	wantOperation := enums.StringToACLOperation(m.Operation.ValueString())
+	wantPermission := enums.StringToACLPermissionType(m.PermissionType.ValueString())
+	for _, res := range resources {
+		if res.GetResourceName() != m.ResourceName.ValueString() ||
+			res.GetResourceType() != wantType ||
+			res.GetResourcePatternType() != wantPattern {
+			continue
+		}
+		for _, p := range res.GetAcls() {
+			if p.GetPrincipal() == m.Principal.ValueString() &&
+				p.GetHost() == m.Host.ValueString() &&
+				p.GetOperation() == wantOperation &&
+				p.GetPermissionType() == wantPermission {
+				return res
+			}
+		}
 	}
+	return nil
 }
 
 func (a *ACL) createACLClient(ctx context.Context, clusterURL string) error {
@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 )
 
 // ResourceACLSchema returns the Terraform schema for the acl resource.
@@ -57,9 +59,10 @@ func ResourceACLSchema(_ context.Context) schema.Schema {
 			},
 
 			"principal": schema.StringAttribute{
-				Description:   "The user for whom this ACL applies. With the Kafka simple authorizer, you must include the prefix \"User:\" with the user name.",
+				Description:   "The principal this ACL applies to. Must be prefixed with `User:` (SASL users, mTLS-mapped certificate principals, or `User:*`), `Group:` (OIDC group claims) or `RedpandaRole:` (Redpanda roles).",
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{validators.ACLPrincipal()},
 			},
 
 			"resource_name": schema.StringAttribute{
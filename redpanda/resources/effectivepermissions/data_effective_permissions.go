@@ -0,0 +1,302 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package effectivepermissions contains the redpanda_effective_permissions
+// datasource, which resolves every ACL that applies to a principal.
+package effectivepermissions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc"
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/enums"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+)
+
+const rolePrincipalPrefix = "RedpandaRole:"
+
+var _ datasource.DataSource = &DataSourceEffectivePermissions{}
+
+// DataSourceEffectivePermissions resolves the ACLs that apply to a principal:
+// its own, those granted to every role it is a member of, and those granted
+// to the wildcard principal.
+type DataSourceEffectivePermissions struct {
+	base.DataSourceBase
+
+	SecurityClient consolev1alpha1grpc.SecurityServiceClient
+	ACLClient      dataplanev1grpc.ACLServiceClient
+
+	dsData config.Datasource
+}
+
+// NewDataSourceEffectivePermissions constructs an EffectivePermissions datasource.
+func NewDataSourceEffectivePermissions() *DataSourceEffectivePermissions {
+	d := &DataSourceEffectivePermissions{}
+	d.DataSourceBase = base.NewDataSourceBase("redpanda_effective_permissions", DataSourceEffectivePermissionsSchema, func(p config.Datasource) {
+		d.dsData = p
+	})
+	return d
+}
+
+// DataSourceEffectivePermissionsSchema defines the schema for the
+// EffectivePermissions data source.
+func DataSourceEffectivePermissionsSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Resolves every ACL that applies to a principal, including ACLs inherited through Redpanda roles",
+		Attributes: map[string]schema.Attribute{
+			"cluster_api_url": schema.StringAttribute{
+				Required:    true,
+				Description: "The cluster API URL.",
+			},
+			"principal": schema.StringAttribute{
+				Required:    true,
+				Description: "The principal to resolve, e.g. `User:alice`.",
+				Validators:  []validator.String{validators.PrincipalPrefix()},
+			},
+			"roles": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Names of the Redpanda roles the principal is a member of.",
+			},
+			"permissions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "ACLs that apply to the principal, sorted by source, resource and operation",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Computed:    true,
+							Description: "The principal the ACL is granted to: the principal itself, the wildcard principal (e.g. `User:*`) or `RedpandaRole:<name>`",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of resource the ACL targets",
+						},
+						"resource_name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the resource the ACL targets",
+						},
+						"resource_pattern_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "How resource_name is matched (LITERAL or PREFIXED)",
+						},
+						"host": schema.StringAttribute{
+							Computed:    true,
+							Description: "The host the ACL applies to",
+						},
+						"operation": schema.StringAttribute{
+							Computed:    true,
+							Description: "The operation that is allowed or denied",
+						},
+						"permission_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the operation is allowed or denied (ALLOW or DENY)",
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The resolved principal",
+			},
+		},
+	}
+}
+
+// Read resolves the roles of the principal and collects the ACLs of every
+// principal that applies to it.
+func (d *DataSourceEffectivePermissions) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model models.EffectivePermissions
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	principal := model.Principal.ValueString()
+
+	if err := d.createClients(ctx, model.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create clients", err.Error())
+		return
+	}
+
+	roles, err := listPrincipalRoles(ctx, d.SecurityClient, principal)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list roles of %q", principal), utils.DeserializeGrpcError(err))
+		return
+	}
+
+	permissions := []models.EffectivePermission{}
+	for _, source := range permissionSources(principal, roles) {
+		resources, err := listACLs(ctx, d.ACLClient, source)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to list ACLs of %q", source), utils.DeserializeGrpcError(err))
+			return
+		}
+		permissions = append(permissions, flattenPermissions(source, resources)...)
+	}
+	sortPermissions(permissions)
+
+	rolesList, diags := types.ListValueFrom(ctx, types.StringType, roles)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	model.Roles = rolesList
+	model.Permissions = permissions
+	model.ID = types.StringValue(principal)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// permissionSources returns every ACL principal whose grants apply to
+// principal: itself, the wildcard principal of its type and its roles.
+func permissionSources(principal string, roles []string) []string {
+	sources := []string{principal}
+	if kind, _, ok := strings.Cut(principal, ":"); ok && kind == "User" && principal != "User:*" {
+		sources = append(sources, "User:*")
+	}
+	for _, r := range roles {
+		sources = append(sources, rolePrincipalPrefix+r)
+	}
+	return sources
+}
+
+// flattenPermissions converts the ListACLs response into permission entries,
+// keeping only the policies granted to source.
+func flattenPermissions(source string, resources []*dataplanev1.ListACLsResponse_Resource) []models.EffectivePermission {
+	var out []models.EffectivePermission
+	for _, res := range resources {
+		for _, p := range res.GetAcls() {
+			if p.GetPrincipal() != source {
+				continue
+			}
+			out = append(out, models.EffectivePermission{
+				Source:              types.StringValue(source),
+				ResourceType:        types.StringValue(enums.ACLResourceTypeToString(res.GetResourceType())),
+				ResourceName:        types.StringValue(res.GetResourceName()),
+				ResourcePatternType: types.StringValue(enums.ACLResourcePatternTypeToString(res.GetResourcePatternType())),
+				Host:                types.StringValue(p.GetHost()),
+				Operation:           types.StringValue(enums.ACLOperationToString(p.GetOperation())),
+				PermissionType:      types.StringValue(enums.ACLPermissionTypeToString(p.GetPermissionType())),
+			})
+		}
+	}
+	return out
+}
+
+// sortPermissions orders the entries so the list is stable across reads.
+func sortPermissions(perms []models.EffectivePermission) {
+	key := func(p *models.EffectivePermission) string {
+		return strings.Join([]string{
+			p.Source.ValueString(),
+			p.ResourceType.ValueString(),
+			p.ResourceName.ValueString(),
+			p.ResourcePatternType.ValueString(),
+			p.Operation.ValueString(),
+			p.PermissionType.ValueString(),
+			p.Host.ValueString(),
+		}, "\x00")
+	}
+	sort.SliceStable(perms, func(i, j int) bool { return key(&perms[i]) < key(&perms[j]) })
+}
+
+// listPrincipalRoles returns the sorted names of the roles the principal is a
+// member of.
+func listPrincipalRoles(ctx context.Context, cl consolev1alpha1grpc.SecurityServiceClient, principal string) ([]string, error) {
+	roles := []string{}
+	pageToken := ""
+	for {
+		resp, err := cl.ListRoles(ctx, &consolev1alpha1.ListRolesRequest{
+			Request: &dataplanev1.ListRolesRequest{
+				Filter:    &dataplanev1.ListRolesRequest_Filter{Principal: principal},
+				PageToken: pageToken,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resp.GetResponse().GetRoles() {
+			roles = append(roles, r.GetName())
+		}
+		pageToken = resp.GetResponse().GetNextPageToken()
+		if pageToken == "" {
+			sort.Strings(roles)
+			return roles, nil
+		}
+	}
+}
+
+// listACLs returns the ACL bindings of a single principal.
+func listACLs(ctx context.Context, cl dataplanev1grpc.ACLServiceClient, principal string) ([]*dataplanev1.ListACLsResponse_Resource, error) {
+	var resp *dataplanev1.ListACLsResponse
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		var rpcErr error
+		resp, rpcErr = cl.ListACLs(ctx, &dataplanev1.ListACLsRequest{
+			Filter: &dataplanev1.ListACLsRequest_Filter{
+				ResourceType:        dataplanev1.ACL_RESOURCE_TYPE_ANY,
+				ResourcePatternType: dataplanev1.ACL_RESOURCE_PATTERN_TYPE_ANY,
+				Principal:           &principal,
+				Operation:           dataplanev1.ACL_OPERATION_ANY,
+				PermissionType:      dataplanev1.ACL_PERMISSION_TYPE_ANY,
+			},
+		})
+		if rpcErr != nil {
+			if utils.IsUnavailable(rpcErr) {
+				return utils.RetryableError(rpcErr)
+			}
+			return utils.NonRetryableError(rpcErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetResources(), nil
+}
+
+func (d *DataSourceEffectivePermissions) createClients(ctx context.Context, clusterURL string) error {
+	if d.ACLClient == nil {
+		client, err := utils.NewDataplaneClient(ctx, d.dsData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewACLServiceClient)
+		if err != nil {
+			return err
+		}
+		d.ACLClient = client
+	}
+	if d.SecurityClient != nil {
+		return nil
+	}
+	if d.dsData.DataplaneConnPool == nil {
+		return errors.New("provider not configured: dataplane connection pool is nil")
+	}
+	consoleURL := utils.SecurityServiceURL(ctx, d.CpCl, clusterURL)
+	conn, err := d.dsData.DataplaneConnPool.GetCachedConnection(ctx, consoleURL)
+	if err != nil {
+		return fmt.Errorf("unable to open a connection with the console API at %s: %v", consoleURL, err)
+	}
+	d.SecurityClient = utils.CapabilityAware(clusterURL, d.CpCl, consolev1alpha1grpc.NewSecurityServiceClient)(conn)
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package effectivepermissions
+
+import (
+	"context"
+	"testing"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+// fakeACLClient answers ListACLs from a fixed set of resources, filtering by
+// the requested principal the way the server does.
+type fakeACLClient struct {
+	dataplanev1grpc.ACLServiceClient
+	policies map[string][]*dataplanev1.ListACLsResponse_Resource
+}
+
+func (f *fakeACLClient) ListACLs(_ context.Context, in *dataplanev1.ListACLsRequest, _ ...grpc.CallOption) (*dataplanev1.ListACLsResponse, error) {
+	return &dataplanev1.ListACLsResponse{Resources: f.policies[in.GetFilter().GetPrincipal()]}, nil
+}
+
+func topicACL(principal, topic string, op dataplanev1.ACL_Operation, perm dataplanev1.ACL_PermissionType) *dataplanev1.ListACLsResponse_Resource {
+	return &dataplanev1.ListACLsResponse_Resource{
+		ResourceType:        dataplanev1.ACL_RESOURCE_TYPE_TOPIC,
+		ResourceName:        topic,
+		ResourcePatternType: dataplanev1.ACL_RESOURCE_PATTERN_TYPE_LITERAL,
+		Acls: []*dataplanev1.ListACLsResponse_Policy{{
+			Principal:      principal,
+			Host:           "*",
+			Operation:      op,
+			PermissionType: perm,
+		}},
+	}
+}
+
+func TestPermissionSources(t *testing.T) {
+	assert.Equal(t,
+		[]string{"User:alice", "User:*", "RedpandaRole:dev", "RedpandaRole:ops"},
+		permissionSources("User:alice", []string{"dev", "ops"}))
+	assert.Equal(t, []string{"User:*"}, permissionSources("User:*", nil))
+	assert.Equal(t, []string{"Group:eng", "RedpandaRole:dev"}, permissionSources("Group:eng", []string{"dev"}))
+}
+
+func TestFlattenPermissions_SkipsOtherPrincipals(t *testing.T) {
+	res := topicACL("User:bob", "orders", dataplanev1.ACL_OPERATION_READ, dataplanev1.ACL_PERMISSION_TYPE_ALLOW)
+	assert.Empty(t, flattenPermissions("User:alice", []*dataplanev1.ListACLsResponse_Resource{res}))
+}
+
+func TestDataSourceEffectivePermissions_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	security := mocks.NewMockSecurityServiceClient(ctrl)
+	security.EXPECT().
+		ListRoles(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *consolev1alpha1.ListRolesRequest, _ ...grpc.CallOption) (*consolev1alpha1.ListRolesResponse, error) {
+			assert.Equal(t, "User:alice", req.GetRequest().GetFilter().GetPrincipal())
+			return &consolev1alpha1.ListRolesResponse{
+				Response: &dataplanev1.ListRolesResponse{
+					Roles: []*dataplanev1.Role{{Name: "developer"}},
+				},
+			}, nil
+		})
+
+	acls := &fakeACLClient{policies: map[string][]*dataplanev1.ListACLsResponse_Resource{
+		"User:alice": {topicACL("User:alice", "payments", dataplanev1.ACL_OPERATION_WRITE, dataplanev1.ACL_PERMISSION_TYPE_DENY)},
+		"User:*":     {topicACL("User:*", "public", dataplanev1.ACL_OPERATION_READ, dataplanev1.ACL_PERMISSION_TYPE_ALLOW)},
+		"RedpandaRole:developer": {
+			topicACL("RedpandaRole:developer", "orders", dataplanev1.ACL_OPERATION_WRITE, dataplanev1.ACL_PERMISSION_TYPE_ALLOW),
+			topicACL("RedpandaRole:developer", "orders", dataplanev1.ACL_OPERATION_READ, dataplanev1.ACL_PERMISSION_TYPE_ALLOW),
+		},
+	}}
+
+	d := NewDataSourceEffectivePermissions()
+	d.SecurityClient = security
+	d.ACLClient = acls
+
+	s := DataSourceEffectivePermissionsSchema(ctx)
+	cfg := tfsdk.State{Schema: s}
+	require.False(t, cfg.Set(ctx, &models.EffectivePermissions{
+		ClusterAPIURL: types.StringValue("https://api.example.com"),
+		Principal:     types.StringValue("User:alice"),
+		Roles:         types.ListNull(types.StringType),
+		ID:            types.StringNull(),
+	}).HasError())
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: s}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: s, Raw: cfg.Raw}}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "Read should not error: %v", resp.Diagnostics)
+
+	var got models.EffectivePermissions
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, "User:alice", got.ID.ValueString())
+	assert.Equal(t, []string{"developer"}, func() []string {
+		var out []string
+		got.Roles.ElementsAs(ctx, &out, false)
+		return out
+	}())
+
+	var summary []string
+	for _, p := range got.Permissions {
+		summary = append(summary, p.Source.ValueString()+" "+p.PermissionType.ValueString()+" "+p.Operation.ValueString()+" "+p.ResourceName.ValueString())
+	}
+	assert.Equal(t, []string{
+		"RedpandaRole:developer ALLOW READ orders",
+		"RedpandaRole:developer ALLOW WRITE orders",
+		"User:* ALLOW READ public",
+		"User:alice DENY WRITE payments",
+	}, summary)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	groupmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/group"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var _ datasource.DataSource = &DataSourceGroups{}
+
+// DataSourceGroups represents a data source for the groups of the Redpanda
+// Cloud organization.
+type DataSourceGroups struct {
+	base.DataSourceBase
+}
+
+// NewDataSourceGroups constructs a Groups datasource.
+func NewDataSourceGroups() *DataSourceGroups {
+	d := &DataSourceGroups{}
+	d.DataSourceBase = base.NewDataSourceBase("redpanda_groups", DataSourceGroupsSchema, nil)
+	return d
+}
+
+// DataSourceGroupsSchema defines the schema for a Groups data source.
+func DataSourceGroupsSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Data source for the groups of the Redpanda Cloud organization",
+		Attributes: map[string]schema.Attribute{
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Groups in the organization",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the group",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the group",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the group",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists every group in the organization and updates the state.
+func (d *DataSourceGroups) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var groups []*iamv1.Group
+	pageToken := ""
+	for {
+		page, err := d.CpCl.Group.ListGroups(ctx, &iamv1.ListGroupsRequest{PageToken: pageToken})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list groups", utils.DeserializeGrpcError(err))
+			return
+		}
+		groups = append(groups, page.GetGroups()...)
+		pageToken = page.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &groupmodel.GroupsDataModel{Groups: groupmodel.FlattenGroups(groups)})...)
+}
@@ -0,0 +1,170 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package group contains the implementation of the redpanda_group and
+// redpanda_group_membership resources and the redpanda_groups datasource,
+// which manage Redpanda Cloud organization groups.
+package group
+
+import (
+	"context"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	groupmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/group"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var (
+	_ resource.Resource                = &Group{}
+	_ resource.ResourceWithConfigure   = &Group{}
+	_ resource.ResourceWithImportState = &Group{}
+)
+
+// Group represents the Redpanda Cloud organization group resource.
+type Group struct {
+	base.ResourceBase
+}
+
+// NewGroup constructs a Group resource.
+func NewGroup() *Group {
+	r := &Group{}
+	r.ResourceBase = base.NewResourceBase("redpanda_group", ResourceGroupSchema, nil)
+	return r
+}
+
+// ResourceGroupSchema returns the schema for the Group resource.
+func ResourceGroupSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "A Redpanda Cloud organization group. Roles bound to a group with redpanda_role_binding apply to all of its members",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique name of the group",
+			},
+			"description": schema.StringAttribute{
+				Optional:    true,
+				Description: "The description of the group",
+			},
+		},
+	}
+}
+
+// Create creates a new Group.
+func (r *Group) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupmodel.ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "creating group", map[string]any{"name": plan.Name.ValueString()})
+	apiResp, err := r.CpCl.Group.CreateGroup(ctx, plan.ToCreateRequest())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create group", utils.DeserializeGrpcError(err))
+		return
+	}
+	g := apiResp.GetGroup()
+	if g == nil {
+		resp.Diagnostics.AddError("failed to create group", "API response did not contain a group; please report this bug to Redpanda Support")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, groupmodel.Flatten(g, &plan))...)
+}
+
+// Read refreshes Group state.
+func (r *Group) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupmodel.ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	g, err := r.CpCl.GroupForID(ctx, state.ID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read group", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, groupmodel.Flatten(g, &state))...)
+}
+
+// Update applies name/description changes via FieldMask.
+func (r *Group) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state groupmodel.ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload, mask := utils.PlanPayloadWithUpdateMask(plan.ToUpdate(), state.ToUpdate())
+	apiResp, err := r.CpCl.Group.UpdateGroup(ctx, &iamv1.UpdateGroupRequest{
+		Id:         state.ID.ValueString(),
+		Group:      payload,
+		UpdateMask: mask,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update group", utils.DeserializeGrpcError(err))
+		return
+	}
+	g := apiResp.GetGroup()
+	if g == nil {
+		resp.Diagnostics.AddError("failed to update group", "API response did not contain a group; please report this bug to Redpanda Support")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, groupmodel.Flatten(g, &plan))...)
+}
+
+// Delete deletes the Group.
+func (r *Group) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupmodel.ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "deleting group", map[string]any{"id": state.ID.ValueString()})
+	if _, err := r.CpCl.Group.DeleteGroup(ctx, &iamv1.DeleteGroupRequest{Id: state.ID.ValueString()}); err != nil {
+		if utils.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("failed to delete group", utils.DeserializeGrpcError(err))
+	}
+}
+
+// ImportState imports a Group by its ID.
+func (*Group) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
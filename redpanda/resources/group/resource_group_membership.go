@@ -0,0 +1,183 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package group
+
+import (
+	"context"
+	"fmt"
+
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/iam/v1/iamv1grpc"
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	groupmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/group"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var (
+	_ resource.Resource                = &GroupMembership{}
+	_ resource.ResourceWithConfigure   = &GroupMembership{}
+	_ resource.ResourceWithImportState = &GroupMembership{}
+)
+
+// GroupMembership represents the membership of one organization user in a
+// Redpanda Cloud group.
+type GroupMembership struct {
+	base.ResourceBase
+}
+
+// NewGroupMembership constructs a GroupMembership resource.
+func NewGroupMembership() *GroupMembership {
+	r := &GroupMembership{}
+	r.ResourceBase = base.NewResourceBase("redpanda_group_membership", ResourceGroupMembershipSchema, nil)
+	return r
+}
+
+// ResourceGroupMembershipSchema returns the schema for the GroupMembership resource.
+func ResourceGroupMembershipSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Adds an organization user to a Redpanda Cloud group",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource. Format: `{group_id}:{user_id}`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the organization user to add to the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the user to the group.
+func (r *GroupMembership) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupmodel.MembershipModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID, userID := plan.GroupID.ValueString(), plan.UserID.ValueString()
+	tflog.Info(ctx, "adding user to group", map[string]any{"group_id": groupID, "user_id": userID})
+	if _, err := r.CpCl.Group.UpdateGroupMembership(ctx, &iamv1.UpdateGroupMembershipRequest{
+		GroupId: groupID,
+		Add:     []string{userID},
+	}); err != nil {
+		resp.Diagnostics.AddError("failed to add user to group", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	plan.ID = types.StringValue(groupmodel.MembershipID(groupID, userID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read checks that the user is still a member of the group.
+func (r *GroupMembership) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupmodel.MembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isMember, err := groupHasMember(ctx, r.CpCl.Group, state.GroupID.ValueString(), state.UserID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read group membership", utils.DeserializeGrpcError(err))
+		return
+	}
+	if !isMember {
+		tflog.Warn(ctx, "group membership not found, removing from state", map[string]any{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is not supported; every attribute requires replacement.
+func (*GroupMembership) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+// Delete removes the user from the group.
+func (r *GroupMembership) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupmodel.MembershipModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.CpCl.Group.UpdateGroupMembership(ctx, &iamv1.UpdateGroupMembershipRequest{
+		GroupId: state.GroupID.ValueString(),
+		Remove:  []string{state.UserID.ValueString()},
+	}); err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to remove user from group", utils.DeserializeGrpcError(err))
+	}
+}
+
+// ImportState imports a GroupMembership via "<group_id>:<user_id>".
+func (*GroupMembership) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID, userID, err := groupmodel.ParseMembershipID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &groupmodel.MembershipModel{
+		ID:      types.StringValue(req.ID),
+		GroupID: types.StringValue(groupID),
+		UserID:  types.StringValue(userID),
+	})...)
+}
+
+// groupHasMember pages through the members of a group looking for userID.
+func groupHasMember(ctx context.Context, cl iamv1grpc.GroupServiceClient, groupID, userID string) (bool, error) {
+	pageToken := ""
+	for {
+		resp, err := cl.ListGroupMembers(ctx, &iamv1.ListGroupMembersRequest{GroupId: groupID, PageToken: pageToken})
+		if err != nil {
+			return false, fmt.Errorf("unable to list members of group %q: %w", groupID, err)
+		}
+		for _, m := range resp.GetMembers() {
+			if m.GetUserId() == userID {
+				return true, nil
+			}
+		}
+		pageToken = resp.GetNextPageToken()
+		if pageToken == "" {
+			return false, nil
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package organizationuser
+
+import (
+	"context"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	orgusermodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/organizationuser"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var _ datasource.DataSource = &DataSourceOrganizationUsers{}
+
+// DataSourceOrganizationUsers represents a data source for the users of the
+// Redpanda Cloud organization.
+type DataSourceOrganizationUsers struct {
+	base.DataSourceBase
+}
+
+// NewDataSourceOrganizationUsers constructs an OrganizationUsers datasource.
+func NewDataSourceOrganizationUsers() *DataSourceOrganizationUsers {
+	d := &DataSourceOrganizationUsers{}
+	d.DataSourceBase = base.NewDataSourceBase("redpanda_organization_users", DataSourceOrganizationUsersSchema, nil)
+	return d
+}
+
+// DataSourceOrganizationUsersSchema defines the schema for an OrganizationUsers data source.
+func DataSourceOrganizationUsersSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Data source for the users of the Redpanda Cloud organization",
+		Attributes: map[string]schema.Attribute{
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Users that are members of the organization",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user",
+						},
+						"email": schema.StringAttribute{
+							Computed:    true,
+							Description: "The email address of the user",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the user",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read lists every user in the organization and updates the state.
+func (d *DataSourceOrganizationUsers) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var users []*iamv1.User
+	pageToken := ""
+	for {
+		page, err := d.CpCl.User.ListUsers(ctx, &iamv1.ListUsersRequest{PageToken: pageToken})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to list organization users", utils.DeserializeGrpcError(err))
+			return
+		}
+		users = append(users, page.GetUsers()...)
+		pageToken = page.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &orgusermodel.UsersDataModel{Users: orgusermodel.FlattenUsers(users)})...)
+}
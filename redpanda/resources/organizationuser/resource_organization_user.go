@@ -0,0 +1,222 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package organizationuser contains the implementation of the
+// redpanda_organization_user resource, which invites users to the Redpanda
+// Cloud organization, and the redpanda_organization_users datasource.
+package organizationuser
+
+import (
+	"context"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	orgusermodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/organizationuser"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var (
+	_ resource.Resource                = &OrganizationUser{}
+	_ resource.ResourceWithConfigure   = &OrganizationUser{}
+	_ resource.ResourceWithImportState = &OrganizationUser{}
+)
+
+// OrganizationUser represents the Redpanda Cloud organization user resource.
+type OrganizationUser struct {
+	base.ResourceBase
+}
+
+// NewOrganizationUser constructs an OrganizationUser resource.
+func NewOrganizationUser() *OrganizationUser {
+	r := &OrganizationUser{}
+	r.ResourceBase = base.NewResourceBase("redpanda_organization_user", ResourceOrganizationUserSchema, nil)
+	return r
+}
+
+// ResourceOrganizationUserSchema returns the schema for the OrganizationUser resource.
+func ResourceOrganizationUserSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Invites a user to the Redpanda Cloud organization and tracks them as a member once the invite is accepted",
+		Attributes: map[string]schema.Attribute{
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "The email address to invite",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of this resource, which is the user's email address",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"invite_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the invite sent to the user. Null for imported users",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the organization user. Null until the invite is accepted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the organization user. Null until the invite is accepted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Computed:    true,
+				Description: "The state of the user: INVITED until the invite is accepted, ACTIVE afterwards",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create sends an invite for the user.
+func (r *OrganizationUser) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan orgusermodel.ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "inviting organization user", map[string]any{"email": plan.Email.ValueString()})
+	apiResp, err := r.CpCl.UserInvite.CreateUserInvite(ctx, &iamv1.CreateUserInviteRequest{
+		UserInvite: &iamv1.UserInviteCreate{Email: plan.Email.ValueString()},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to invite user", utils.DeserializeGrpcError(err))
+		return
+	}
+	inv := apiResp.GetUserInvite()
+	if inv == nil {
+		resp.Diagnostics.AddError("failed to invite user", "API response did not contain a user invite; please report this bug to Redpanda Support")
+		return
+	}
+
+	plan.FlattenInvite(inv)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes OrganizationUser state, following the invite through to the
+// organization user once it has been accepted.
+func (r *OrganizationUser) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state orgusermodel.ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	found, err := refreshOrganizationUser(ctx, r.CpCl, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read organization user", utils.DeserializeGrpcError(err))
+		return
+	}
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update is not supported; email requires replacement.
+func (*OrganizationUser) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+// Delete removes the user from the organization, or revokes the invite when
+// it has not been accepted yet.
+func (r *OrganizationUser) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state orgusermodel.ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if userID := state.UserID.ValueString(); userID != "" {
+		tflog.Info(ctx, "removing organization user", map[string]any{"user_id": userID})
+		_, err = r.CpCl.User.DeleteUser(ctx, &iamv1.DeleteUserRequest{Id: userID})
+	} else {
+		tflog.Info(ctx, "revoking user invite", map[string]any{"invite_id": state.InviteID.ValueString()})
+		_, err = r.CpCl.UserInvite.DeleteUserInvite(ctx, &iamv1.DeleteUserInviteRequest{Id: state.InviteID.ValueString()})
+	}
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete organization user", utils.DeserializeGrpcError(err))
+	}
+}
+
+// ImportState imports an existing organization member by email address.
+func (*OrganizationUser) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// refreshOrganizationUser updates m from the API and reports whether the user
+// or its pending invite still exists. An invite that disappears is looked up
+// by email: accepted invites become organization users, while revoked or
+// expired ones leave nothing behind.
+func refreshOrganizationUser(ctx context.Context, cl cloud.CpClientSet, m *orgusermodel.ResourceModel) (bool, error) {
+	if userID := m.UserID.ValueString(); userID != "" {
+		u, err := cl.UserForID(ctx, userID)
+		if err != nil {
+			if utils.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		m.FlattenUser(u)
+		return true, nil
+	}
+
+	if inviteID := m.InviteID.ValueString(); inviteID != "" {
+		inv, err := cl.UserInviteForID(ctx, inviteID)
+		if err == nil {
+			m.FlattenInvite(inv)
+			return true, nil
+		}
+		if !utils.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	u, err := cl.UserForEmail(ctx, m.Email.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	m.FlattenUser(u)
+	return true, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package organizationuser
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	orgusermodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/organizationuser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnit_OrganizationUser_Refresh(t *testing.T) {
+	ctx := context.Background()
+	notFound := status.Error(codes.NotFound, "not found")
+	invited := func() *orgusermodel.ResourceModel {
+		return &orgusermodel.ResourceModel{
+			ID:       types.StringValue("ada@example.com"),
+			Email:    types.StringValue("ada@example.com"),
+			InviteID: types.StringValue("inv-1"),
+			UserID:   types.StringNull(),
+			Name:     types.StringNull(),
+			State:    types.StringValue(orgusermodel.StateInvited),
+		}
+	}
+	ada := &iamv1.User{Id: "user-1", Email: "ada@example.com", Name: "Ada"}
+
+	t.Run("pending invite", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().UserInviteForID(ctx, "inv-1").Return(&iamv1.UserInvite{Id: "inv-1", Email: "ada@example.com"}, nil)
+
+		m := invited()
+		found, err := refreshOrganizationUser(ctx, cl, m)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, invited(), m)
+	})
+
+	t.Run("accepted invite becomes a user", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		gomock.InOrder(
+			cl.EXPECT().UserInviteForID(ctx, "inv-1").Return(nil, notFound),
+			cl.EXPECT().UserForEmail(ctx, "ada@example.com").Return(ada, nil),
+		)
+
+		m := invited()
+		found, err := refreshOrganizationUser(ctx, cl, m)
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, types.StringValue("user-1"), m.UserID)
+		assert.Equal(t, types.StringValue("inv-1"), m.InviteID, "the invite ID is kept after acceptance")
+		assert.Equal(t, types.StringValue(orgusermodel.StateActive), m.State)
+	})
+
+	t.Run("expired invite is gone", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().UserInviteForID(ctx, "inv-1").Return(nil, notFound)
+		cl.EXPECT().UserForEmail(ctx, "ada@example.com").Return(nil, errors.New(`user "ada@example.com" not found`))
+
+		found, err := refreshOrganizationUser(ctx, cl, invited())
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("removed user is gone", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().UserForID(ctx, "user-1").Return(nil, notFound)
+
+		m := invited()
+		m.UserID = types.StringValue("user-1")
+		found, err := refreshOrganizationUser(ctx, cl, m)
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("invite lookup failure", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().UserInviteForID(ctx, "inv-1").Return(nil, errors.New("permission denied"))
+
+		_, err := refreshOrganizationUser(ctx, cl, invited())
+		require.Error(t, err)
+	})
+}
@@ -544,7 +544,7 @@ func (p *Pipeline) createPipelineClient(ctx context.Context, clusterURL string)
 		return nil
 	}
 
-	client, err := utils.NewDataplaneClient(ctx, p.resData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewPipelineServiceClient)
+	client, err := utils.NewDataplaneClient(ctx, p.resData.DataplaneConnPool, clusterURL, utils.CapabilityAware(clusterURL, p.CpCl, dataplanev1grpc.NewPipelineServiceClient))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,293 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package principalmapping
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Redpanda's defaults for the mapping properties. A property that is not set
+// on the cluster behaves as if it held these values.
+const (
+	defaultOIDCPrincipalMapping = "$.sub"
+	defaultOIDCGroupClaimPath   = "$.groups"
+	defaultMTLSRule             = "DEFAULT"
+)
+
+// rulePart is the pattern/replacement/flag suffix shared by mTLS rules and
+// the OIDC principal mapping. Slashes inside pattern and replacement are
+// escaped as `\/`, as in Kafka's ssl.principal.mapping.rules.
+const rulePart = `((?:\\/|[^/])*)/((?:\\/|[^/])*)/([LU]?)`
+
+var (
+	mtlsRulePattern    = regexp.MustCompile(`^(?:DEFAULT|RULE:` + rulePart + `)$`)
+	oidcMappingPattern = regexp.MustCompile(`^(\$(?:\.[^./]+)+)(?:/` + rulePart + `)?$`)
+	claimPathPattern   = regexp.MustCompile(`^\$(?:\.[^./]+)+$`)
+	groupReference     = regexp.MustCompile(`\$(\d+)`)
+)
+
+// mappingRule is a parsed RULE:pattern/replacement/[LU] entry. A nil pattern
+// is the DEFAULT rule, which passes its input through unchanged.
+type mappingRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+	caseFlag    string
+}
+
+// newMappingRule compiles the pattern and replacement captured by rulePart.
+// The pattern must match the whole input, and `$1`-style group references are
+// rewritten to `${1}` so that Go expands them the way Java does.
+func newMappingRule(pattern, replacement, caseFlag string) (mappingRule, error) {
+	re, err := regexp.Compile(`^(?:` + strings.ReplaceAll(pattern, `\/`, `/`) + `)$`)
+	if err != nil {
+		return mappingRule{}, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return mappingRule{
+		pattern:     re,
+		replacement: groupReference.ReplaceAllString(strings.ReplaceAll(replacement, `\/`, `/`), `$${$1}`),
+		caseFlag:    caseFlag,
+	}, nil
+}
+
+// apply maps in through the rule, reporting false when the pattern does not
+// match.
+func (r mappingRule) apply(in string) (string, bool) {
+	if r.pattern == nil {
+		return in, true
+	}
+	m := r.pattern.FindStringSubmatchIndex(in)
+	if m == nil {
+		return "", false
+	}
+	out := string(r.pattern.ExpandString(nil, r.replacement, in, m))
+	switch r.caseFlag {
+	case "L":
+		out = strings.ToLower(out)
+	case "U":
+		out = strings.ToUpper(out)
+	}
+	return out, true
+}
+
+// parseMTLSRule parses one entry of kafka_mtls_principal_mapping_rules.
+func parseMTLSRule(s string) (mappingRule, error) {
+	m := mtlsRulePattern.FindStringSubmatch(s)
+	if m == nil {
+		return mappingRule{}, fmt.Errorf("%q must be DEFAULT or RULE:pattern/replacement/ with an optional L or U suffix", s)
+	}
+	if s == defaultMTLSRule {
+		return mappingRule{}, nil
+	}
+	return newMappingRule(m[1], m[2], m[3])
+}
+
+// mapCertificateDN returns the principal name the first matching rule derives
+// from a client certificate's distinguished name. Empty rules behave like
+// DEFAULT, which uses the whole DN.
+func mapCertificateDN(rules []string, dn string) (string, error) {
+	if len(rules) == 0 {
+		rules = []string{defaultMTLSRule}
+	}
+	for _, s := range rules {
+		rule, err := parseMTLSRule(s)
+		if err != nil {
+			return "", err
+		}
+		if name, ok := rule.apply(dn); ok {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("no mTLS principal mapping rule matches %q; the client would be rejected", dn)
+}
+
+// oidcMapping is a parsed oidc_principal_mapping: a claim path and an
+// optional rule applied to the claim's value.
+type oidcMapping struct {
+	claim []string
+	rule  mappingRule
+}
+
+// parseOIDCMapping parses oidc_principal_mapping, e.g. `$.sub` or
+// `$.email/([^@]+)@.*/$1/L`.
+func parseOIDCMapping(s string) (oidcMapping, error) {
+	m := oidcMappingPattern.FindStringSubmatch(s)
+	if m == nil {
+		return oidcMapping{}, fmt.Errorf("%q must be a claim path such as $.sub, optionally followed by /pattern/replacement/ and an L or U suffix", s)
+	}
+	out := oidcMapping{claim: splitClaimPath(m[1])}
+	if strings.HasPrefix(s[len(m[1]):], "/") {
+		rule, err := newMappingRule(m[2], m[3], m[4])
+		if err != nil {
+			return oidcMapping{}, err
+		}
+		out.rule = rule
+	}
+	return out, nil
+}
+
+// parseClaimPath parses a plain `$.a.b` claim path such as
+// oidc_group_claim_path.
+func parseClaimPath(s string) ([]string, error) {
+	if !claimPathPattern.MatchString(s) {
+		return nil, fmt.Errorf("%q must be a claim path such as $.groups", s)
+	}
+	return splitClaimPath(s), nil
+}
+
+func splitClaimPath(s string) []string {
+	return strings.Split(strings.TrimPrefix(s, "$."), ".")
+}
+
+// lookupClaim walks a decoded token payload along a claim path.
+func lookupClaim(claims map[string]any, path []string) (any, bool) {
+	var cur any = claims
+	for _, key := range path {
+		obj, ok := cur.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = obj[key]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+// decodeClaims parses a sample token payload.
+func decodeClaims(sample string) (map[string]any, error) {
+	var claims map[string]any
+	if err := json.Unmarshal([]byte(sample), &claims); err != nil {
+		return nil, fmt.Errorf("sample token claims must be a JSON object: %w", err)
+	}
+	return claims, nil
+}
+
+// mapTokenPrincipal returns the principal name oidc_principal_mapping derives
+// from a token payload.
+func mapTokenPrincipal(mapping string, claims map[string]any) (string, error) {
+	m, err := parseOIDCMapping(mapping)
+	if err != nil {
+		return "", err
+	}
+	v, ok := lookupClaim(claims, m.claim)
+	if !ok {
+		return "", fmt.Errorf("claim %s is not present in the sample token; the client would be rejected", mapping)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("claim %s is not a string", strings.Join(m.claim, "."))
+	}
+	name, ok := m.rule.apply(s)
+	if !ok || name == "" {
+		return "", fmt.Errorf("oidc_principal_mapping %q does not match claim value %q; the client would be rejected", mapping, s)
+	}
+	return name, nil
+}
+
+// mapTokenGroups returns the group names oidc_group_claim_path extracts from a
+// token payload. A missing claim yields no groups; the claim may hold a single
+// string or a list of strings.
+func mapTokenGroups(claimPath string, claims map[string]any) ([]string, error) {
+	path, err := parseClaimPath(claimPath)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := lookupClaim(claims, path)
+	if !ok {
+		return nil, nil
+	}
+	switch v := v.(type) {
+	case string:
+		return []string{v}, nil
+	case []any:
+		groups := make([]string, 0, len(v))
+		for _, g := range v {
+			s, ok := g.(string)
+			if !ok {
+				return nil, errors.New("group claim must contain only strings")
+			}
+			groups = append(groups, s)
+		}
+		return groups, nil
+	default:
+		return nil, errors.New("group claim must be a string or a list of strings")
+	}
+}
+
+var _ validator.String = syntaxValidator{}
+
+// syntaxValidator rejects values the given parser does not accept, so that
+// malformed rules fail at plan time rather than when the cluster applies
+// them.
+type syntaxValidator struct {
+	description string
+	parse       func(string) error
+}
+
+func mtlsRuleValidator() syntaxValidator {
+	return syntaxValidator{
+		description: "value must be DEFAULT or RULE:pattern/replacement/[LU]",
+		parse:       func(s string) error { _, err := parseMTLSRule(s); return err },
+	}
+}
+
+func oidcMappingValidator() syntaxValidator {
+	return syntaxValidator{
+		description: "value must be a claim path such as $.sub with an optional /pattern/replacement/[LU] rule",
+		parse:       func(s string) error { _, err := parseOIDCMapping(s); return err },
+	}
+}
+
+func claimPathValidator() syntaxValidator {
+	return syntaxValidator{
+		description: "value must be a claim path such as $.groups",
+		parse:       func(s string) error { _, err := parseClaimPath(s); return err },
+	}
+}
+
+func claimsJSONValidator() syntaxValidator {
+	return syntaxValidator{
+		description: "value must be a JSON object",
+		parse:       func(s string) error { _, err := decodeClaims(s); return err },
+	}
+}
+
+// Description describes the validator.
+func (v syntaxValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+// MarkdownDescription describes the validator in markdown.
+func (v syntaxValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString runs the parser over known values.
+func (v syntaxValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := v.parse(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid principal mapping", err.Error())
+	}
+}
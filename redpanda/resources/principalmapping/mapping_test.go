@@ -0,0 +1,216 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package principalmapping
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMapCertificateDN(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []string
+		dn      string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no rules uses the whole DN",
+			dn:   "CN=alice,OU=eng,O=Example",
+			want: "CN=alice,OU=eng,O=Example",
+		},
+		{
+			name:  "DEFAULT uses the whole DN",
+			rules: []string{"DEFAULT"},
+			dn:    "CN=alice,O=Example",
+			want:  "CN=alice,O=Example",
+		},
+		{
+			name:  "common name extracted",
+			rules: []string{"RULE:^CN=([^,]+),.*$/$1/"},
+			dn:    "CN=alice,OU=eng,O=Example",
+			want:  "alice",
+		},
+		{
+			name:  "lower case flag",
+			rules: []string{"RULE:CN=([^,]+),.*/$1/L"},
+			dn:    "CN=Alice,O=Example",
+			want:  "alice",
+		},
+		{
+			name:  "upper case flag",
+			rules: []string{"RULE:CN=([^,]+),.*/$1/U"},
+			dn:    "CN=Alice,O=Example",
+			want:  "ALICE",
+		},
+		{
+			name:  "group reference followed by text",
+			rules: []string{"RULE:CN=([^,]+),O=([^,]+)/$1svc@$2/"},
+			dn:    "CN=billing,O=Example",
+			want:  "billingsvc@Example",
+		},
+		{
+			name:  "escaped slash in replacement",
+			rules: []string{`RULE:CN=([^,]+),O=([^,]+)/$2\/$1/`},
+			dn:    "CN=alice,O=Example",
+			want:  "Example/alice",
+		},
+		{
+			name:  "first matching rule wins",
+			rules: []string{"RULE:CN=([^,]+),OU=ops,.*/ops-$1/", "RULE:CN=([^,]+),.*/$1/", "DEFAULT"},
+			dn:    "CN=bob,OU=ops,O=Example",
+			want:  "ops-bob",
+		},
+		{
+			name:  "falls through to DEFAULT",
+			rules: []string{"RULE:CN=([^,]+),OU=ops,.*/$1/", "DEFAULT"},
+			dn:    "CN=bob,OU=eng,O=Example",
+			want:  "CN=bob,OU=eng,O=Example",
+		},
+		{
+			name:    "pattern must match the whole DN",
+			rules:   []string{"RULE:CN=([^,]+)/$1/"},
+			dn:      "CN=alice,O=Example",
+			wantErr: true,
+		},
+		{
+			name:    "malformed rule",
+			rules:   []string{"RULE:CN=(.*)"},
+			dn:      "CN=alice",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapCertificateDN(tt.rules, tt.dn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mapCertificateDN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mapCertificateDN() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapTokenPrincipal(t *testing.T) {
+	claims := map[string]any{
+		"sub":   "0f3c6a52",
+		"email": "Alice@Example.com",
+		"ext":   map[string]any{"username": "alice"},
+		"admin": true,
+	}
+	tests := []struct {
+		name    string
+		mapping string
+		want    string
+		wantErr bool
+	}{
+		{name: "default subject", mapping: "$.sub", want: "0f3c6a52"},
+		{name: "nested claim", mapping: "$.ext.username", want: "alice"},
+		{name: "rule applied to claim", mapping: "$.email/([^@]+)@.*/$1/L", want: "alice"},
+		{name: "rule does not match", mapping: "$.email/([^@]+)@corp\\.com/$1/", wantErr: true},
+		{name: "missing claim", mapping: "$.preferred_username", wantErr: true},
+		{name: "non-string claim", mapping: "$.admin", wantErr: true},
+		{name: "not a claim path", mapping: "sub", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapTokenPrincipal(tt.mapping, claims)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mapTokenPrincipal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mapTokenPrincipal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapTokenGroups(t *testing.T) {
+	claims := map[string]any{
+		"groups": []any{"eng", "ops"},
+		"team":   "billing",
+		"realm":  map[string]any{"roles": []any{"admin"}},
+		"bad":    []any{"eng", 1.0},
+	}
+	tests := []struct {
+		name    string
+		path    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "list claim", path: "$.groups", want: []string{"eng", "ops"}},
+		{name: "string claim", path: "$.team", want: []string{"billing"}},
+		{name: "nested claim", path: "$.realm.roles", want: []string{"admin"}},
+		{name: "missing claim", path: "$.missing"},
+		{name: "non-string member", path: "$.bad", wantErr: true},
+		{name: "rule not allowed", path: "$.groups/(.*)/$1/", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mapTokenGroups(tt.path, claims)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mapTokenGroups() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mapTokenGroups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyntaxValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantError bool
+	}{
+		{"mtls DEFAULT", mtlsRuleValidator(), types.StringValue("DEFAULT"), false},
+		{"mtls rule", mtlsRuleValidator(), types.StringValue("RULE:CN=(.*)/$1/L"), false},
+		{"mtls rule missing replacement", mtlsRuleValidator(), types.StringValue("RULE:CN=(.*)"), true},
+		{"mtls rule bad flag", mtlsRuleValidator(), types.StringValue("RULE:CN=(.*)/$1/X"), true},
+		{"mtls rule bad regex", mtlsRuleValidator(), types.StringValue("RULE:CN=(.*/$1/"), true},
+		{"oidc claim", oidcMappingValidator(), types.StringValue("$.sub"), false},
+		{"oidc claim with rule", oidcMappingValidator(), types.StringValue("$.email/([^@]+)@.*/$1/"), false},
+		{"oidc missing root", oidcMappingValidator(), types.StringValue("email"), true},
+		{"group claim path", claimPathValidator(), types.StringValue("$.realm_access.roles"), false},
+		{"group claim path with rule", claimPathValidator(), types.StringValue("$.groups/(.*)/$1/"), true},
+		{"claims object", claimsJSONValidator(), types.StringValue(`{"sub":"alice"}`), false},
+		{"claims array", claimsJSONValidator(), types.StringValue(`["alice"]`), true},
+		{"null skipped", mtlsRuleValidator(), types.StringNull(), false},
+		{"unknown skipped", oidcMappingValidator(), types.StringUnknown(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("value"),
+				ConfigValue: tt.value,
+			}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantError {
+				t.Errorf("want error=%v, got error=%v (diags=%v)", tt.wantError, got, resp.Diagnostics)
+			}
+		})
+	}
+}
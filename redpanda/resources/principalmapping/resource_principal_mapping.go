@@ -0,0 +1,514 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package principalmapping contains the implementation of the
+// redpanda_principal_mapping resource, which manages how OIDC tokens and mTLS
+// client certificates are mapped to Kafka principals on a cluster.
+package principalmapping
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Cluster configuration properties owned by this resource.
+const (
+	oidcPrincipalMappingProperty = "oidc_principal_mapping"
+	oidcGroupClaimPathProperty   = "oidc_group_claim_path"
+	mtlsMappingRulesProperty     = "kafka_mtls_principal_mapping_rules"
+)
+
+var (
+	_ resource.Resource                     = &PrincipalMapping{}
+	_ resource.ResourceWithConfigure        = &PrincipalMapping{}
+	_ resource.ResourceWithImportState      = &PrincipalMapping{}
+	_ resource.ResourceWithModifyPlan       = &PrincipalMapping{}
+	_ resource.ResourceWithConfigValidators = &PrincipalMapping{}
+)
+
+// PrincipalMapping implements the resource interface for a cluster's OIDC and
+// mTLS principal-mapping rules.
+type PrincipalMapping struct {
+	base.ResourceBase
+}
+
+// NewPrincipalMapping creates a new instance of the principal mapping
+// resource.
+func NewPrincipalMapping() *PrincipalMapping {
+	r := &PrincipalMapping{}
+	r.ResourceBase = base.NewResourceBase("redpanda_principal_mapping", ResourcePrincipalMappingSchema, nil)
+	return r
+}
+
+// ResourcePrincipalMappingSchema returns the schema for the principal mapping
+// resource.
+func ResourcePrincipalMappingSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages the rules that map OIDC tokens and mTLS client certificates to Kafka principals on a cluster. The rules are stored in the cluster's `cluster_configuration`; only the properties set here are managed. Optional sample inputs are mapped at plan time so the resulting principals can be reviewed before apply.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the cluster whose configuration holds the mapping rules",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"oidc_principal_mapping": schema.StringAttribute{
+				MarkdownDescription: "Rule mapping an OIDC token to a `User:` principal: a claim path, optionally followed by `/pattern/replacement/` and an `L` or `U` case flag, e.g. `$.email/([^@]+)@.*/$1/L`. Sets the `oidc_principal_mapping` cluster property (Redpanda default `$.sub`).",
+				Optional:            true,
+				Validators: []validator.String{
+					oidcMappingValidator(),
+				},
+			},
+			"oidc_group_claim_path": schema.StringAttribute{
+				MarkdownDescription: "Claim path holding the token's groups, each of which becomes a `Group:` principal. Sets the `oidc_group_claim_path` cluster property (Redpanda default `$.groups`).",
+				Optional:            true,
+				Validators: []validator.String{
+					claimPathValidator(),
+				},
+			},
+			"mtls_principal_mapping_rules": schema.ListAttribute{
+				MarkdownDescription: "Ordered rules mapping a client certificate's distinguished name to a `User:` principal. Each rule is `DEFAULT` or `RULE:pattern/replacement/` with an optional `L` or `U` case flag; the first rule whose pattern matches the whole DN wins. Sets the `kafka_mtls_principal_mapping_rules` cluster property.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(mtlsRuleValidator()),
+				},
+			},
+			"sample_token_claims": schema.StringAttribute{
+				MarkdownDescription: "JSON payload of a sample OIDC token, used only to compute `oidc_principal_preview` and `oidc_groups_preview`. Never sent to the cluster.",
+				Optional:            true,
+				Validators: []validator.String{
+					claimsJSONValidator(),
+				},
+			},
+			"sample_certificate_dn": schema.StringAttribute{
+				MarkdownDescription: "Distinguished name of a sample client certificate in RFC 2253 form, e.g. `CN=alice,OU=eng,O=Example`, used only to compute `mtls_principal_preview`. Never sent to the cluster.",
+				Optional:            true,
+			},
+			"oidc_principal_preview": schema.StringAttribute{
+				MarkdownDescription: "The principal `sample_token_claims` maps to. Null when no sample is set or the sample would be rejected.",
+				Computed:            true,
+			},
+			"oidc_groups_preview": schema.ListAttribute{
+				MarkdownDescription: "The group principals `sample_token_claims` maps to. Null when no sample is set.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"mtls_principal_preview": schema.StringAttribute{
+				MarkdownDescription: "The principal `sample_certificate_dn` maps to. Null when no sample is set or no rule matches.",
+				Computed:            true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource. Same as `cluster_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators requires at least one mapping property to be managed.
+func (*PrincipalMapping) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("oidc_principal_mapping"),
+			path.MatchRoot("oidc_group_claim_path"),
+			path.MatchRoot("mtls_principal_mapping_rules"),
+		),
+	}
+}
+
+// ModifyPlan maps the sample token and certificate through the planned rules
+// so the resulting principals show up in the plan. A sample that would be
+// rejected is reported as a warning rather than an error: the rules are
+// still valid, the sample just doesn't authenticate.
+func (*PrincipalMapping) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan models.PrincipalMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setPreviews(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("oidc_principal_preview"), plan.OIDCPrincipalPreview)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("oidc_groups_preview"), plan.OIDCGroupsPreview)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mtls_principal_preview"), plan.MTLSPrincipalPreview)...)
+}
+
+// Create writes the configured mapping properties to the cluster.
+func (r *PrincipalMapping) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.PrincipalMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.CpCl.ClusterForID(ctx, plan.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", plan.ClusterID), utils.DeserializeGrpcError(err))
+		return
+	}
+	resp.Diagnostics.Append(r.apply(ctx, cl, &plan, nil, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ClusterID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the managed mapping properties from the cluster
+// configuration.
+func (r *PrincipalMapping) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.PrincipalMapping
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.CpCl.ClusterForID(ctx, state.ClusterID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			tflog.Warn(ctx, "Cluster not found, removing principal mapping from state", map[string]any{"cluster_id": state.ClusterID.ValueString()})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", state.ClusterID), utils.DeserializeGrpcError(err))
+		return
+	}
+
+	resp.Diagnostics.Append(flattenMappingProperties(ctx, cl.GetClusterConfiguration().GetCustomProperties(), &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Sample rejections were already reported at plan time.
+	previewDiags := setPreviews(ctx, &state)
+	if previewDiags.HasError() {
+		resp.Diagnostics.Append(previewDiags...)
+		return
+	}
+	state.ID = state.ClusterID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update writes the changed mapping properties to the cluster and removes the
+// ones dropped from the configuration.
+func (r *PrincipalMapping) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.PrincipalMapping
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := plan.Timeouts.Update(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.CpCl.ClusterForID(ctx, plan.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", plan.ClusterID), utils.DeserializeGrpcError(err))
+		return
+	}
+	resp.Diagnostics.Append(r.apply(ctx, cl, &plan, &state, timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ClusterID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the managed properties from the cluster configuration, which
+// restores Redpanda's defaults for them.
+func (r *PrincipalMapping) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.PrincipalMapping
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	timeout, diags := state.Timeouts.Delete(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cl, err := r.CpCl.ClusterForID(ctx, state.ClusterID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read cluster %s", state.ClusterID), utils.DeserializeGrpcError(err))
+		return
+	}
+	cleared := models.PrincipalMapping{
+		ClusterID:                 state.ClusterID,
+		OIDCPrincipalMapping:      types.StringNull(),
+		OIDCGroupClaimPath:        types.StringNull(),
+		MTLSPrincipalMappingRules: types.ListNull(types.StringType),
+	}
+	resp.Diagnostics.Append(r.apply(ctx, cl, &cleared, &state, timeout)...)
+}
+
+// ImportState imports the mapping properties of a cluster by cluster ID. The
+// imported resource starts out managing every mapping property set on the
+// cluster.
+func (*PrincipalMapping) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// apply merges the mapping properties of plan into the custom properties of
+// cl and sends a single cluster update when anything changed. prior is nil on
+// Create.
+func (r *PrincipalMapping) apply(ctx context.Context, cl *controlplanev1.Cluster, plan, prior *models.PrincipalMapping, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	clusterID := cl.GetId()
+
+	current := cl.GetClusterConfiguration().GetCustomProperties()
+	props, mergeDiags := mergeMappingProperties(ctx, current, plan, prior)
+	diags.Append(mergeDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	if proto.Equal(props, current) {
+		return diags
+	}
+
+	tflog.Info(ctx, "Updating cluster principal mapping", map[string]any{"cluster_id": clusterID})
+	op, err := r.CpCl.Cluster.UpdateCluster(ctx, &controlplanev1.UpdateClusterRequest{
+		Cluster: &controlplanev1.ClusterUpdate{
+			Id: clusterID,
+			ClusterConfiguration: &controlplanev1.ClusterUpdate_ClusterConfiguration{
+				CustomProperties: props,
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"cluster_configuration"}},
+	})
+	if err != nil {
+		diags.AddError("failed to send cluster update request", utils.DeserializeGrpcError(err))
+		return diags
+	}
+	if err := utils.AreWeDoneYet(ctx, op.GetOperation(), timeout, r.CpCl.Operation); err != nil {
+		diags.AddError("failed while waiting to update cluster", utils.DeserializeGrpcError(err))
+	}
+	return diags
+}
+
+// mergeMappingProperties returns a copy of current with the mapping
+// properties set in m written over it. Properties that were managed in prior
+// but are now null are removed so the cluster falls back to its default;
+// properties never managed by this resource are left untouched.
+func mergeMappingProperties(ctx context.Context, current *structpb.Struct, m, prior *models.PrincipalMapping) (*structpb.Struct, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := &structpb.Struct{Fields: map[string]*structpb.Value{}}
+	for k, v := range current.GetFields() {
+		out.Fields[k] = v
+	}
+
+	setString := func(key string, v, was types.String) {
+		switch {
+		case !v.IsNull():
+			out.Fields[key] = structpb.NewStringValue(v.ValueString())
+		case prior != nil && !was.IsNull():
+			delete(out.Fields, key)
+		}
+	}
+	var priorOIDC, priorGroups types.String
+	priorRules := types.ListNull(types.StringType)
+	if prior != nil {
+		priorOIDC, priorGroups, priorRules = prior.OIDCPrincipalMapping, prior.OIDCGroupClaimPath, prior.MTLSPrincipalMappingRules
+	}
+	setString(oidcPrincipalMappingProperty, m.OIDCPrincipalMapping, priorOIDC)
+	setString(oidcGroupClaimPathProperty, m.OIDCGroupClaimPath, priorGroups)
+
+	switch {
+	case !m.MTLSPrincipalMappingRules.IsNull():
+		var rules []string
+		diags.Append(m.MTLSPrincipalMappingRules.ElementsAs(ctx, &rules, false)...)
+		values := make([]*structpb.Value, len(rules))
+		for i, rule := range rules {
+			values[i] = structpb.NewStringValue(rule)
+		}
+		out.Fields[mtlsMappingRulesProperty] = structpb.NewListValue(&structpb.ListValue{Values: values})
+	case !priorRules.IsNull():
+		delete(out.Fields, mtlsMappingRulesProperty)
+	}
+
+	if len(out.Fields) == 0 && current == nil {
+		return nil, diags
+	}
+	return out, diags
+}
+
+// flattenMappingProperties copies the mapping properties from the cluster's
+// custom properties into m. Only properties already managed in m are
+// refreshed, unless m manages none of them (right after import), in which case
+// every mapping property present on the cluster is adopted.
+func flattenMappingProperties(ctx context.Context, props *structpb.Struct, m *models.PrincipalMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+	adopt := m.OIDCPrincipalMapping.IsNull() && m.OIDCGroupClaimPath.IsNull() && m.MTLSPrincipalMappingRules.IsNull()
+	fields := props.GetFields()
+
+	readString := func(key string, v types.String) types.String {
+		if v.IsNull() && !adopt {
+			return v
+		}
+		if f, ok := fields[key]; ok && f.GetStringValue() != "" {
+			return types.StringValue(f.GetStringValue())
+		}
+		return types.StringNull()
+	}
+	m.OIDCPrincipalMapping = readString(oidcPrincipalMappingProperty, m.OIDCPrincipalMapping)
+	m.OIDCGroupClaimPath = readString(oidcGroupClaimPathProperty, m.OIDCGroupClaimPath)
+
+	if !m.MTLSPrincipalMappingRules.IsNull() || adopt {
+		var rules []string
+		switch f := fields[mtlsMappingRulesProperty]; {
+		case f.GetListValue() != nil:
+			for _, v := range f.GetListValue().GetValues() {
+				rules = append(rules, v.GetStringValue())
+			}
+		case f.GetStringValue() != "":
+			rules = []string{f.GetStringValue()}
+		}
+		if len(rules) == 0 {
+			m.MTLSPrincipalMappingRules = types.ListNull(types.StringType)
+		} else {
+			list, d := types.ListValueFrom(ctx, types.StringType, rules)
+			diags.Append(d...)
+			m.MTLSPrincipalMappingRules = list
+		}
+	}
+	return diags
+}
+
+// setPreviews fills the preview attributes of m from its samples and rules.
+// Previews are unknown while any input is, and null when no sample is set.
+// A sample that doesn't map to a principal yields a warning.
+func setPreviews(ctx context.Context, m *models.PrincipalMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.OIDCPrincipalPreview = types.StringNull()
+	m.OIDCGroupsPreview = types.ListNull(types.StringType)
+	switch {
+	case m.SampleTokenClaims.IsUnknown() || m.OIDCPrincipalMapping.IsUnknown() || m.OIDCGroupClaimPath.IsUnknown():
+		m.OIDCPrincipalPreview = types.StringUnknown()
+		m.OIDCGroupsPreview = types.ListUnknown(types.StringType)
+	case !m.SampleTokenClaims.IsNull():
+		claims, err := decodeClaims(m.SampleTokenClaims.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("sample_token_claims"), "Invalid sample token claims", err.Error())
+			return diags
+		}
+		if name, err := mapTokenPrincipal(valueOr(m.OIDCPrincipalMapping, defaultOIDCPrincipalMapping), claims); err != nil {
+			diags.AddAttributeWarning(path.Root("sample_token_claims"), "Sample token does not map to a principal", err.Error())
+		} else {
+			m.OIDCPrincipalPreview = types.StringValue("User:" + name)
+		}
+		groups, err := mapTokenGroups(valueOr(m.OIDCGroupClaimPath, defaultOIDCGroupClaimPath), claims)
+		if err != nil {
+			diags.AddAttributeWarning(path.Root("sample_token_claims"), "Sample token groups are ignored", err.Error())
+			groups = nil
+		}
+		principals := make([]string, len(groups))
+		for i, g := range groups {
+			principals[i] = "Group:" + g
+		}
+		list, d := types.ListValueFrom(ctx, types.StringType, principals)
+		diags.Append(d...)
+		m.OIDCGroupsPreview = list
+	}
+
+	m.MTLSPrincipalPreview = types.StringNull()
+	switch {
+	case m.SampleCertificateDN.IsUnknown() || listHasUnknown(m.MTLSPrincipalMappingRules):
+		m.MTLSPrincipalPreview = types.StringUnknown()
+	case !m.SampleCertificateDN.IsNull():
+		var rules []string
+		if !m.MTLSPrincipalMappingRules.IsNull() {
+			diags.Append(m.MTLSPrincipalMappingRules.ElementsAs(ctx, &rules, false)...)
+		}
+		if name, err := mapCertificateDN(rules, m.SampleCertificateDN.ValueString()); err != nil {
+			diags.AddAttributeWarning(path.Root("sample_certificate_dn"), "Sample certificate does not map to a principal", err.Error())
+		} else {
+			m.MTLSPrincipalPreview = types.StringValue("User:" + name)
+		}
+	}
+	return diags
+}
+
+// listHasUnknown reports whether l or any of its elements is unknown.
+func listHasUnknown(l types.List) bool {
+	if l.IsUnknown() {
+		return true
+	}
+	for _, v := range l.Elements() {
+		if v.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// valueOr returns the value of v, or def when v is null.
+func valueOr(v types.String, def string) string {
+	if v.IsNull() {
+		return def
+	}
+	return v.ValueString()
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package principalmapping
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func mustStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func stringList(t *testing.T, vals ...string) types.List {
+	t.Helper()
+	l, diags := types.ListValueFrom(context.Background(), types.StringType, vals)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	return l
+}
+
+func nullMapping() *models.PrincipalMapping {
+	return &models.PrincipalMapping{
+		ClusterID:                 types.StringValue("cl-1"),
+		OIDCPrincipalMapping:      types.StringNull(),
+		OIDCGroupClaimPath:        types.StringNull(),
+		MTLSPrincipalMappingRules: types.ListNull(types.StringType),
+		SampleTokenClaims:         types.StringNull(),
+		SampleCertificateDN:       types.StringNull(),
+	}
+}
+
+func TestMergeMappingProperties(t *testing.T) {
+	ctx := context.Background()
+	current := mustStruct(t, map[string]any{
+		"audit_enabled":          true,
+		"oidc_principal_mapping": "$.email",
+		"oidc_group_claim_path":  "$.roles",
+	})
+
+	t.Run("sets configured properties and keeps others", func(t *testing.T) {
+		plan := nullMapping()
+		plan.OIDCPrincipalMapping = types.StringValue("$.sub")
+		plan.MTLSPrincipalMappingRules = stringList(t, "RULE:CN=(.*)/$1/", "DEFAULT")
+
+		got, diags := mergeMappingProperties(ctx, current, plan, nil)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		want := mustStruct(t, map[string]any{
+			"audit_enabled":                      true,
+			"oidc_principal_mapping":             "$.sub",
+			"oidc_group_claim_path":              "$.roles",
+			"kafka_mtls_principal_mapping_rules": []any{"RULE:CN=(.*)/$1/", "DEFAULT"},
+		})
+		if !proto.Equal(got, want) {
+			t.Errorf("mergeMappingProperties() = %v, want %v", got, want)
+		}
+		if current.Fields["oidc_principal_mapping"].GetStringValue() != "$.email" {
+			t.Error("mergeMappingProperties() modified its input")
+		}
+	})
+
+	t.Run("removes properties dropped from the configuration", func(t *testing.T) {
+		prior := nullMapping()
+		prior.OIDCPrincipalMapping = types.StringValue("$.email")
+		plan := nullMapping()
+		plan.MTLSPrincipalMappingRules = stringList(t, "DEFAULT")
+
+		got, diags := mergeMappingProperties(ctx, current, plan, prior)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		want := mustStruct(t, map[string]any{
+			"audit_enabled":                      true,
+			"oidc_group_claim_path":              "$.roles",
+			"kafka_mtls_principal_mapping_rules": []any{"DEFAULT"},
+		})
+		if !proto.Equal(got, want) {
+			t.Errorf("mergeMappingProperties() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("unchanged configuration yields equal properties", func(t *testing.T) {
+		plan := nullMapping()
+		plan.OIDCPrincipalMapping = types.StringValue("$.email")
+
+		got, diags := mergeMappingProperties(ctx, current, plan, plan)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if !proto.Equal(got, current) {
+			t.Errorf("mergeMappingProperties() = %v, want %v", got, current)
+		}
+	})
+}
+
+func TestFlattenMappingProperties(t *testing.T) {
+	ctx := context.Background()
+	props := mustStruct(t, map[string]any{
+		"oidc_principal_mapping":             "$.email",
+		"oidc_group_claim_path":              "$.roles",
+		"kafka_mtls_principal_mapping_rules": []any{"RULE:CN=(.*)/$1/"},
+	})
+
+	t.Run("refreshes managed properties only", func(t *testing.T) {
+		m := nullMapping()
+		m.OIDCPrincipalMapping = types.StringValue("$.sub")
+		if diags := flattenMappingProperties(ctx, props, m); diags.HasError() {
+			t.Fatal(diags)
+		}
+		if got := m.OIDCPrincipalMapping.ValueString(); got != "$.email" {
+			t.Errorf("oidc_principal_mapping = %q, want $.email", got)
+		}
+		if !m.OIDCGroupClaimPath.IsNull() || !m.MTLSPrincipalMappingRules.IsNull() {
+			t.Errorf("unmanaged properties were adopted: %v, %v", m.OIDCGroupClaimPath, m.MTLSPrincipalMappingRules)
+		}
+	})
+
+	t.Run("managed property removed on the cluster becomes null", func(t *testing.T) {
+		m := nullMapping()
+		m.MTLSPrincipalMappingRules = stringList(t, "DEFAULT")
+		if diags := flattenMappingProperties(ctx, &structpb.Struct{}, m); diags.HasError() {
+			t.Fatal(diags)
+		}
+		if !m.MTLSPrincipalMappingRules.IsNull() {
+			t.Errorf("mtls_principal_mapping_rules = %v, want null", m.MTLSPrincipalMappingRules)
+		}
+	})
+
+	t.Run("import adopts every property", func(t *testing.T) {
+		m := nullMapping()
+		if diags := flattenMappingProperties(ctx, props, m); diags.HasError() {
+			t.Fatal(diags)
+		}
+		if got := m.OIDCGroupClaimPath.ValueString(); got != "$.roles" {
+			t.Errorf("oidc_group_claim_path = %q, want $.roles", got)
+		}
+		if !m.MTLSPrincipalMappingRules.Equal(stringList(t, "RULE:CN=(.*)/$1/")) {
+			t.Errorf("mtls_principal_mapping_rules = %v", m.MTLSPrincipalMappingRules)
+		}
+	})
+}
+
+func TestSetPreviews(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("no samples", func(t *testing.T) {
+		m := nullMapping()
+		m.OIDCPrincipalMapping = types.StringValue("$.email")
+		if diags := setPreviews(ctx, m); diags.HasError() {
+			t.Fatal(diags)
+		}
+		if !m.OIDCPrincipalPreview.IsNull() || !m.OIDCGroupsPreview.IsNull() || !m.MTLSPrincipalPreview.IsNull() {
+			t.Errorf("previews = %v, %v, %v, want null", m.OIDCPrincipalPreview, m.OIDCGroupsPreview, m.MTLSPrincipalPreview)
+		}
+	})
+
+	t.Run("samples mapped through configured rules and defaults", func(t *testing.T) {
+		m := nullMapping()
+		m.OIDCPrincipalMapping = types.StringValue("$.email/([^@]+)@.*/$1/L")
+		m.SampleTokenClaims = types.StringValue(`{"sub":"0f3c","email":"Alice@example.com","groups":["eng","ops"]}`)
+		m.MTLSPrincipalMappingRules = stringList(t, "RULE:CN=([^,]+),.*/$1/")
+		m.SampleCertificateDN = types.StringValue("CN=svc-billing,O=Example")
+		diags := setPreviews(ctx, m)
+		if diags.HasError() || diags.WarningsCount() != 0 {
+			t.Fatal(diags)
+		}
+		if got := m.OIDCPrincipalPreview.ValueString(); got != "User:alice" {
+			t.Errorf("oidc_principal_preview = %q, want User:alice", got)
+		}
+		if !m.OIDCGroupsPreview.Equal(stringList(t, "Group:eng", "Group:ops")) {
+			t.Errorf("oidc_groups_preview = %v", m.OIDCGroupsPreview)
+		}
+		if got := m.MTLSPrincipalPreview.ValueString(); got != "User:svc-billing" {
+			t.Errorf("mtls_principal_preview = %q, want User:svc-billing", got)
+		}
+	})
+
+	t.Run("rejected samples warn", func(t *testing.T) {
+		m := nullMapping()
+		m.SampleTokenClaims = types.StringValue(`{"email":"alice@example.com"}`)
+		m.MTLSPrincipalMappingRules = stringList(t, "RULE:CN=([^,]+),OU=ops,.*/$1/")
+		m.SampleCertificateDN = types.StringValue("CN=alice,OU=eng,O=Example")
+		diags := setPreviews(ctx, m)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if got := diags.WarningsCount(); got != 2 {
+			t.Errorf("got %d warnings, want 2: %v", got, diags)
+		}
+		if !m.OIDCPrincipalPreview.IsNull() || !m.MTLSPrincipalPreview.IsNull() {
+			t.Errorf("previews = %v, %v, want null", m.OIDCPrincipalPreview, m.MTLSPrincipalPreview)
+		}
+		if !m.OIDCGroupsPreview.Equal(stringList(t, []string{}...)) {
+			t.Errorf("oidc_groups_preview = %v, want empty", m.OIDCGroupsPreview)
+		}
+	})
+
+	t.Run("unknown inputs", func(t *testing.T) {
+		m := nullMapping()
+		m.SampleTokenClaims = types.StringValue(`{"sub":"alice"}`)
+		m.OIDCPrincipalMapping = types.StringUnknown()
+		m.SampleCertificateDN = types.StringValue("CN=alice")
+		m.MTLSPrincipalMappingRules = types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()})
+		if diags := setPreviews(ctx, m); diags.HasError() {
+			t.Fatal(diags)
+		}
+		if !m.OIDCPrincipalPreview.IsUnknown() || !m.OIDCGroupsPreview.IsUnknown() || !m.MTLSPrincipalPreview.IsUnknown() {
+			t.Errorf("previews = %v, %v, %v, want unknown", m.OIDCPrincipalPreview, m.OIDCGroupsPreview, m.MTLSPrincipalPreview)
+		}
+	})
+}
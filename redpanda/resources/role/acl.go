@@ -0,0 +1,102 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package role
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	rolemodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/role"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+// The schemagen validator registry emits the ACL enum validators as raw
+// package-local expressions (see the acl package), so the role schema needs
+// its own copies for the nested acl attribute.
+
+func mapValueToValidator(cutset string, m map[int32]string) []validator.String {
+	types := make([]string, 0, len(m))
+	for _, v := range m {
+		types = append(types, strings.TrimPrefix(v, cutset))
+	}
+	return []validator.String{stringvalidator.OneOf(types...)}
+}
+
+func aclResourceTypeValidator() []validator.String {
+	return mapValueToValidator("RESOURCE_TYPE_", dataplanev1.ACL_ResourceType_name)
+}
+
+func aclResourcePatternTypeValidator() []validator.String {
+	return mapValueToValidator("RESOURCE_PATTERN_TYPE_", dataplanev1.ACL_ResourcePatternType_name)
+}
+
+func aclOperationValidator() []validator.String {
+	return mapValueToValidator("OPERATION_", dataplanev1.ACL_Operation_name)
+}
+
+func aclPermissionTypeValidator() []validator.String {
+	return mapValueToValidator("PERMISSION_TYPE_", dataplanev1.ACL_PermissionType_name)
+}
+
+// listRoleACLs returns every ACL bound to the role principal. It lists all ACLs
+// of the cluster and leaves FlattenACLs to pick the role's, which lets every
+// role and ACL resource of the cluster share one ListACLs response.
+func listRoleACLs(ctx context.Context, cl dataplanev1grpc.ACLServiceClient, roleName string) ([]rolemodel.AclModel, error) {
+	var resp *dataplanev1.ListACLsResponse
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		var rpcErr error
+		resp, rpcErr = cl.ListACLs(ctx, utils.ListAllACLsRequest())
+		if rpcErr != nil {
+			if utils.IsUnavailable(rpcErr) {
+				return utils.RetryableError(rpcErr)
+			}
+			return utils.NonRetryableError(rpcErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ACLs of role %q: %w", roleName, err)
+	}
+	return rolemodel.FlattenACLs(resp.GetResources(), roleName), nil
+}
+
+// applyRoleACLs creates and deletes ACLs of the role so that it matches
+// desired, given the ACLs it currently has.
+func applyRoleACLs(ctx context.Context, cl dataplanev1grpc.ACLServiceClient, roleName string, desired, current []rolemodel.AclModel) error {
+	create, remove := rolemodel.DiffACLs(desired, current)
+	for i := range remove {
+		resp, err := cl.DeleteACLs(ctx, rolemodel.ExpandACLDelete(roleName, &remove[i]))
+		if err != nil {
+			return fmt.Errorf("failed to delete ACL %s of role %q: %w", remove[i].Key(), roleName, err)
+		}
+		for _, m := range resp.GetMatchingAcls() {
+			if m.GetError() != nil && m.GetError().GetCode() != 0 {
+				return fmt.Errorf("failed to delete ACL %s of role %q: %s", remove[i].Key(), roleName, m.GetError().GetMessage())
+			}
+		}
+	}
+	for i := range create {
+		if _, err := cl.CreateACL(ctx, rolemodel.ExpandACLCreate(roleName, &create[i])); err != nil && !utils.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create ACL %s of role %q: %w", create[i].Key(), roleName, err)
+		}
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc"
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
 	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
 	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,6 +50,7 @@ type Role struct {
 	base.ResourceBase
 
 	SecurityClient consolev1alpha1grpc.SecurityServiceClient
+	ACLClient      dataplanev1grpc.ACLServiceClient
 	resData        config.Resource
 	clientFactory  SecurityServiceClientFactory
 }
@@ -66,12 +68,12 @@ func NewRole() *Role {
 					if r.resData.DataplaneConnPool == nil {
 						return nil, errors.New("provider not configured: dataplane connection pool is nil")
 					}
-					consoleURL := utils.ConvertToConsoleURL(clusterURL)
-					conn, err := r.resData.DataplaneConnPool.GetConnection(ctx, consoleURL)
+					consoleURL := utils.SecurityServiceURL(ctx, r.CpCl, clusterURL)
+					conn, err := r.resData.DataplaneConnPool.GetCachedConnection(ctx, consoleURL)
 					if err != nil {
 						return nil, fmt.Errorf("unable to open a connection with the console API at %s: %v", consoleURL, err)
 					}
-					return consolev1alpha1grpc.NewSecurityServiceClient(conn), nil
+					return utils.CapabilityAware(clusterURL, r.CpCl, consolev1alpha1grpc.NewSecurityServiceClient)(conn), nil
 				}
 			}
 		},
@@ -102,6 +104,27 @@ func (r *Role) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		return
 	}
 
+	if !model.Acl.IsNull() {
+		acls, aDiags := model.AsAcl(ctx)
+		resp.Diagnostics.Append(aDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.createACLClient(ctx, model.ClusterAPIURL.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to create ACL client", utils.DeserializeGrpcError(err))
+			return
+		}
+		// The role exists from here on; persist it so a failed ACL grant
+		// is retried by the next apply instead of orphaning the role.
+		model.ID = types.StringValue(model.Name.ValueString())
+		if err := applyRoleACLs(ctx, r.ACLClient, model.Name.ValueString(), acls, nil); err != nil {
+			resp.Diagnostics.AddError("Failed to create role ACLs", utils.DeserializeGrpcError(err))
+			model.Acl = types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()})
+			resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+			return
+		}
+	}
+
 	model.ID = types.StringValue(model.Name.ValueString())
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
@@ -152,6 +175,26 @@ func (r *Role) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 		}
 		return
 	}
+
+	// Only refresh acl when it is managed here; a null acl leaves the
+	// role's ACLs to redpanda_acl.
+	if !model.Acl.IsNull() {
+		if err := r.createACLClient(ctx, clusterAPIURL); err != nil {
+			resp.Diagnostics.AddError("Failed to create ACL client", utils.DeserializeGrpcError(err))
+			return
+		}
+		acls, err := listRoleACLs(ctx, r.ACLClient, roleName)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read role ACLs", utils.DeserializeGrpcError(err))
+			return
+		}
+		aclSet, aDiags := rolemodel.AclToSet(ctx, acls)
+		resp.Diagnostics.Append(aDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		model.Acl = aclSet
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -160,12 +203,33 @@ func (r *Role) Read(ctx context.Context, req resource.ReadRequest, resp *resourc
 // allow_deletion and delete_acls can flip without recreation, so the
 // plan is written to state directly — without this, the framework
 // raises "provider produced inconsistent result after apply".
-func (*Role) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan rolemodel.ResourceModel
+func (r *Role) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state rolemodel.ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Removing acl from the configuration stops managing the role's ACLs
+	// without revoking them.
+	if !plan.Acl.IsNull() && !plan.Acl.Equal(state.Acl) {
+		desired, dDiags := plan.AsAcl(ctx)
+		resp.Diagnostics.Append(dDiags...)
+		current, cDiags := state.AsAcl(ctx)
+		resp.Diagnostics.Append(cDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.createACLClient(ctx, plan.ClusterAPIURL.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Failed to create ACL client", utils.DeserializeGrpcError(err))
+			return
+		}
+		if err := applyRoleACLs(ctx, r.ACLClient, plan.Name.ValueString(), desired, current); err != nil {
+			resp.Diagnostics.AddError("Failed to update role ACLs", utils.DeserializeGrpcError(err))
+			return
+		}
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
@@ -191,6 +255,23 @@ func (r *Role) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
 		return
 	}
 
+	// delete_acls already drops every ACL of the role server-side.
+	if !model.Acl.IsNull() && !model.DeleteAcls.ValueBool() {
+		current, aDiags := model.AsAcl(ctx)
+		resp.Diagnostics.Append(aDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.createACLClient(ctx, clusterAPIURL); err != nil {
+			resp.Diagnostics.AddError("Failed to create ACL client", utils.DeserializeGrpcError(err))
+			return
+		}
+		if err := applyRoleACLs(ctx, r.ACLClient, roleName, nil, current); err != nil {
+			resp.Diagnostics.AddError("Failed to delete role ACLs", utils.DeserializeGrpcError(err))
+			return
+		}
+	}
+
 	innerReq, diags := rolemodel.ExpandDelete(ctx, &model)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -264,3 +345,15 @@ func (r *Role) createSecurityClient(ctx context.Context, clusterURL string) erro
 	r.SecurityClient = client
 	return nil
 }
+
+func (r *Role) createACLClient(ctx context.Context, clusterURL string) error {
+	if r.ACLClient != nil {
+		return nil
+	}
+	client, err := utils.NewDataplaneClient(ctx, r.resData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewACLServiceClient)
+	if err != nil {
+		return err
+	}
+	r.ACLClient = client
+	return nil
+}
@@ -51,6 +51,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "basic role with minimal fields",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("developer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 			},
@@ -58,6 +59,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "role with allow_deletion=true",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("admin"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -66,6 +68,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "role with allow_deletion=false",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("viewer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -74,6 +77,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "role with allow_deletion unset (defaults to false)",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("operator"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
@@ -82,6 +86,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "role with long name",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("super-long-role-name-with-many-characters-for-testing"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 			},
@@ -89,6 +94,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "role with special characters in name",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("role-with_special.chars"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 			},
@@ -96,6 +102,7 @@ func TestUnit_Role_Create(t *testing.T) {
 		{
 			name: "create fails - API error",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("failing-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 			},
@@ -195,6 +202,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "role exists - preserve all fields",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("developer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -210,6 +218,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "role exists with allow_deletion=false",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("admin"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -225,6 +234,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "role not found + allow_deletion=true - remove from state",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("missing-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -240,6 +250,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "role not found + allow_deletion=false - keep in state with error",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("missing-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -256,6 +267,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "role not found + allow_deletion=null - remove from state cleanly",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("missing-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
@@ -271,6 +283,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "cluster unreachable + allow_deletion=true - remove from state",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("unreachable-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -286,6 +299,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "cluster unreachable + allow_deletion=false - keep in state with error",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("unreachable-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -302,6 +316,7 @@ func TestUnit_Role_Read(t *testing.T) {
 		{
 			name: "empty cluster_api_url - should fail with error",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("invalid-role"),
 				ClusterAPIURL: types.StringValue(""),
 				AllowDeletion: types.BoolValue(true),
@@ -403,12 +418,14 @@ func TestUnit_Role_Update(t *testing.T) {
 		{
 			name: "no changes - validate no-op",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("developer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
 				ID:            types.StringValue("developer"),
 			},
 			plan: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("developer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -418,12 +435,14 @@ func TestUnit_Role_Update(t *testing.T) {
 		{
 			name: "attempt to change allow_deletion - should be ignored (no-op)",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("admin"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
 				ID:            types.StringValue("admin"),
 			},
 			plan: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("admin"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true), // Changed, but should be ignored
@@ -433,12 +452,14 @@ func TestUnit_Role_Update(t *testing.T) {
 		{
 			name: "roles are immutable - update is always no-op",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("viewer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
 				ID:            types.StringValue("viewer"),
 			},
 			plan: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("viewer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
@@ -511,6 +532,7 @@ func TestUnit_Role_Delete(t *testing.T) {
 		{
 			name: "successful deletion with allow_deletion=true",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("developer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -526,6 +548,7 @@ func TestUnit_Role_Delete(t *testing.T) {
 		{
 			name: "deletion blocked with allow_deletion=false",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("admin"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -540,6 +563,7 @@ func TestUnit_Role_Delete(t *testing.T) {
 		{
 			name: "deletion allowed with allow_deletion unset (null treated as allowed)",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("viewer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
@@ -555,6 +579,7 @@ func TestUnit_Role_Delete(t *testing.T) {
 		{
 			name: "deletion fails due to API error",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("failing-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -571,6 +596,7 @@ func TestUnit_Role_Delete(t *testing.T) {
 		{
 			name: "deletion with explicit allow_deletion check",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("test-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -589,6 +615,7 @@ func TestUnit_Role_Delete(t *testing.T) {
 		{
 			name: "deletion of non-existent role (should succeed silently)",
 			initialState: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("non-existent"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -746,6 +773,7 @@ func TestUnit_Role_CreateAndRead(t *testing.T) {
 		{
 			name: "create then read - basic role",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("developer"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -754,6 +782,7 @@ func TestUnit_Role_CreateAndRead(t *testing.T) {
 		{
 			name: "create then read - with allow_deletion=false",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("admin"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -762,6 +791,7 @@ func TestUnit_Role_CreateAndRead(t *testing.T) {
 		{
 			name: "create then read - validate state consistency",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("operator"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
@@ -770,6 +800,7 @@ func TestUnit_Role_CreateAndRead(t *testing.T) {
 		{
 			name: "create then read - no field drift",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("test-role-123"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -860,6 +891,7 @@ func TestUnit_Role_CreateReadDelete(t *testing.T) {
 		{
 			name: "full lifecycle with allow_deletion=true",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("lifecycle-test"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -870,6 +902,7 @@ func TestUnit_Role_CreateReadDelete(t *testing.T) {
 		{
 			name: "delete blocked with allow_deletion=false",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("protected-role"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -880,6 +913,7 @@ func TestUnit_Role_CreateReadDelete(t *testing.T) {
 		{
 			name: "complete lifecycle validation",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("full-test"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -972,6 +1006,7 @@ func TestUnit_Role_CreateReadUpdate(t *testing.T) {
 		{
 			name: "create-read-update-read validates immutability",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("immutable-test"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(true),
@@ -980,6 +1015,7 @@ func TestUnit_Role_CreateReadUpdate(t *testing.T) {
 		{
 			name: "update doesnt corrupt state",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("state-test"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolValue(false),
@@ -988,6 +1024,7 @@ func TestUnit_Role_CreateReadUpdate(t *testing.T) {
 		{
 			name: "read after update returns identical state",
 			input: rolemodel.ResourceModel{
+				Acl:           types.SetNull(types.ObjectType{AttrTypes: rolemodel.AclAttrTypes()}),
 				Name:          types.StringValue("consistency-test"),
 				ClusterAPIURL: types.StringValue("https://api-test.cluster.redpanda.com"),
 				AllowDeletion: types.BoolNull(),
@@ -56,6 +56,43 @@ func ResourceRoleSchema(_ context.Context) schema.Schema {
 				Default:     booldefault.StaticBool(false),
 			},
 
+			"acl": schema.SetNestedAttribute{
+				Description: "ACLs granted to the role principal (`RedpandaRole:<name>`). When set, the role's ACLs are managed by this resource and ACLs added outside Terraform show up as drift.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"host": schema.StringAttribute{
+							Description: "The host address the ACL applies to. Use `*` for any host.",
+							Required:    true,
+						},
+						"operation": schema.StringAttribute{
+							Description: "The operation that is allowed or denied (e.g. READ).",
+							Required:    true,
+							Validators:  aclOperationValidator(),
+						},
+						"permission_type": schema.StringAttribute{
+							Description: "Whether the operation should be allowed or denied (ALLOW or DENY).",
+							Required:    true,
+							Validators:  aclPermissionTypeValidator(),
+						},
+						"resource_name": schema.StringAttribute{
+							Description: "The name of the resource this ACL targets. Use `kafka-cluster` for CLUSTER ACLs.",
+							Required:    true,
+						},
+						"resource_pattern_type": schema.StringAttribute{
+							Description: "The pattern to use for matching resource_name (LITERAL or PREFIXED).",
+							Required:    true,
+							Validators:  aclResourcePatternTypeValidator(),
+						},
+						"resource_type": schema.StringAttribute{
+							Description: "The type of resource (TOPIC, GROUP, CLUSTER, TRANSACTIONAL_ID) this ACL targets.",
+							Required:    true,
+							Validators:  aclResourceTypeValidator(),
+						},
+					},
+				},
+			},
+
 			"id": schema.StringAttribute{
 				Description:   "Unique identifier of the resource.",
 				Computed:      true,
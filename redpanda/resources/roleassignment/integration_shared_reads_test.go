@@ -0,0 +1,94 @@
+//go:build integration
+
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package roleassignment_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/internal/testutil/mock"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/roleassignment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc"
+)
+
+// TestIntegration_RoleAssignment_ReadsShareListRoleMembers refreshes several
+// assignments to the same role on one cluster, each through its own resource
+// instance as Terraform does, and asserts that they share a single
+// ListRoleMembers call through the connection pool's ListCache.
+func TestIntegration_RoleAssignment_ReadsShareListRoleMembers(t *testing.T) {
+	const (
+		roleName = "tfrp-mock-ra-shared"
+		url      = "bufnet"
+		n        = 5
+	)
+	ctx := context.Background()
+	srv := mock.New(t)
+
+	principals := make([]string, n)
+	for i := range principals {
+		principals[i] = fmt.Sprintf("User:member-%d", i)
+	}
+	srv.Security.SeedRoleWithMembers(roleName, principals...)
+
+	cp, err := grpc.NewClient("passthrough:///bufnet", srv.Dialer()...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cp.Close() })
+	providerData := config.Resource{
+		ControlPlaneConnection: cp,
+		DataplaneConnPool: cloud.NewConnPoolWithOpts(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "test"}), "test", "test", cloud.SpawnConnOpts{
+			ExtraDialOptions: srv.Dialer(),
+			InsecureCreds:    true,
+		}),
+	}
+	s := roleassignment.ResourceRoleAssignmentSchema(ctx)
+
+	for _, principal := range principals {
+		r := roleassignment.NewRoleAssignment()
+		var configureResp resource.ConfigureResponse
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: providerData}, &configureResp)
+		require.False(t, configureResp.Diagnostics.HasError(), "%v", configureResp.Diagnostics)
+
+		req := resource.ReadRequest{State: tfsdk.State{Schema: s}}
+		require.False(t, req.State.Set(ctx, &models.RoleAssignment{
+			RoleName:      types.StringValue(roleName),
+			Principal:     types.StringValue(principal),
+			ClusterAPIURL: types.StringValue(url),
+			ID:            types.StringValue(roleName + ":" + principal),
+		}).HasError())
+		resp := resource.ReadResponse{State: tfsdk.State{Schema: s}}
+		r.Read(ctx, req, &resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var got models.RoleAssignment
+		require.False(t, resp.State.Get(ctx, &got).HasError())
+		assert.Equal(t, principal, got.Principal.ValueString(), "assignment must stay in state")
+	}
+
+	assert.Equal(t, 1, srv.CallCount(consolev1alpha1grpc.SecurityService_ListRoleMembers_FullMethodName))
+}
@@ -311,12 +311,12 @@ func (r *RoleAssignment) createSecurityClient(ctx context.Context, clusterURL st
 	if r.resData.DataplaneConnPool == nil {
 		return errors.New("provider not configured: dataplane connection pool is nil")
 	}
-	consoleURL := utils.ConvertToConsoleURL(clusterURL)
-	conn, err := r.resData.DataplaneConnPool.GetConnection(ctx, consoleURL)
+	consoleURL := utils.SecurityServiceURL(ctx, r.CpCl, clusterURL)
+	conn, err := r.resData.DataplaneConnPool.GetCachedConnection(ctx, consoleURL)
 	if err != nil {
 		return fmt.Errorf("unable to open a connection with the console API at %s: %v", consoleURL, err)
 	}
 
-	r.SecurityClient = consolev1alpha1grpc.NewSecurityServiceClient(conn)
+	r.SecurityClient = utils.CapabilityAware(clusterURL, r.CpCl, consolev1alpha1grpc.NewSecurityServiceClient)(conn)
 	return nil
 }
@@ -0,0 +1,107 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package rolebinding
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	rolebindingmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/rolebinding"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var _ datasource.DataSource = &DataSourceRoleBinding{}
+
+// DataSourceRoleBinding represents a data source for a Redpanda Cloud role binding.
+type DataSourceRoleBinding struct {
+	base.DataSourceBase
+}
+
+// NewDataSourceRoleBinding constructs a RoleBinding datasource.
+func NewDataSourceRoleBinding() *DataSourceRoleBinding {
+	d := &DataSourceRoleBinding{}
+	d.DataSourceBase = base.NewDataSourceBase("redpanda_role_binding", DatasourceRoleBindingSchema, nil)
+	return d
+}
+
+// DatasourceRoleBindingSchema returns the schema for the RoleBinding datasource.
+func DatasourceRoleBindingSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Data source for a Redpanda Cloud role binding",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the role binding",
+			},
+			"role_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the granted role",
+			},
+			"scope": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The resource the role is granted on",
+				Attributes: map[string]schema.Attribute{
+					"resource_type": schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of the resource: ORGANIZATION, RESOURCE_GROUP, CLUSTER or SERVERLESS_CLUSTER",
+					},
+					"resource_id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the resource",
+					},
+				},
+			},
+			"principal": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The identity the role is granted to",
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Computed:    true,
+						Description: "The type of the principal: SERVICE_ACCOUNT, USER or GROUP",
+					},
+					"id": schema.StringAttribute{
+						Computed:    true,
+						Description: "The ID of the service account, user or group",
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read reads the RoleBinding data source's values and updates the state.
+func (d *DataSourceRoleBinding) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model rolebindingmodel.ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rb, err := d.CpCl.RoleBindingForID(ctx, model.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read role binding", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	accountType, err := resolveAccountType(ctx, d.CpCl, rb, "")
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read role binding", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, rolebindingmodel.Flatten(rb, accountType))...)
+}
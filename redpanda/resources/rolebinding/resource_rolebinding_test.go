@@ -0,0 +1,74 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package rolebinding
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	rolebindingmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/rolebinding"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnit_RoleBinding_ResolveAccountType(t *testing.T) {
+	ctx := context.Background()
+	account := &iamv1.RoleBinding{Id: "rb-1", AccountId: "acct-1"}
+
+	t.Run("group bindings need no lookup", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		got, err := resolveAccountType(ctx, cl, &iamv1.RoleBinding{GroupId: "grp-1"}, "")
+		require.NoError(t, err)
+		assert.Equal(t, rolebindingmodel.PrincipalGroup, got)
+	})
+
+	t.Run("prior account type is kept", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		got, err := resolveAccountType(ctx, cl, account, rolebindingmodel.PrincipalUser)
+		require.NoError(t, err)
+		assert.Equal(t, rolebindingmodel.PrincipalUser, got)
+	})
+
+	t.Run("existing service account", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().ServiceAccountForID(ctx, "acct-1").Return(&iamv1.ServiceAccount{Id: "acct-1"}, nil)
+		got, err := resolveAccountType(ctx, cl, account, "")
+		require.NoError(t, err)
+		assert.Equal(t, rolebindingmodel.PrincipalServiceAccount, got)
+	})
+
+	t.Run("unknown service account is a user", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().ServiceAccountForID(ctx, "acct-1").Return(nil, status.Error(codes.NotFound, "service account not found"))
+		got, err := resolveAccountType(ctx, cl, account, rolebindingmodel.PrincipalGroup)
+		require.NoError(t, err)
+		assert.Equal(t, rolebindingmodel.PrincipalUser, got)
+	})
+
+	t.Run("lookup failure", func(t *testing.T) {
+		cl := mocks.NewMockCpClientSet(gomock.NewController(t))
+		cl.EXPECT().ServiceAccountForID(ctx, "acct-1").Return(nil, errors.New("permission denied"))
+		_, err := resolveAccountType(ctx, cl, account, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "acct-1")
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package rolebinding
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	rolebindingmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/rolebinding"
+)
+
+// ResourceRoleBindingSchema returns the schema for the RoleBinding resource.
+func ResourceRoleBindingSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Grants a Redpanda Cloud organization role to a service account, user or group on an organization, resource group, cluster or serverless cluster",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the role binding",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the role to grant, for example `Admin`, `Writer` or `Reader`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scope": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The resource the role is granted on",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"resource_type": schema.StringAttribute{
+						Required:    true,
+						Description: "The type of the resource: ORGANIZATION, RESOURCE_GROUP, CLUSTER or SERVERLESS_CLUSTER",
+						Validators: []validator.String{
+							stringvalidator.OneOf(rolebindingmodel.ScopeResourceTypes...),
+						},
+					},
+					"resource_id": schema.StringAttribute{
+						Required:    true,
+						Description: "The ID of the resource. For ORGANIZATION scope this is the organization ID",
+					},
+				},
+			},
+			"principal": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The identity the role is granted to",
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The type of the principal: SERVICE_ACCOUNT, USER or GROUP",
+						Validators: []validator.String{
+							stringvalidator.OneOf(rolebindingmodel.PrincipalTypes...),
+						},
+					},
+					"id": schema.StringAttribute{
+						Required:    true,
+						Description: "The ID of the service account, user or group",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package rolemembers contains the implementation of the redpanda_role_members
+// resource, which manages the member set of a Redpanda role as a whole.
+package rolemembers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/console/v1alpha1/consolev1alpha1grpc"
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/models"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+)
+
+var (
+	_ resource.Resource                = &RoleMembers{}
+	_ resource.ResourceWithConfigure   = &RoleMembers{}
+	_ resource.ResourceWithImportState = &RoleMembers{}
+)
+
+// RoleMembers implements the resource interface for the member set of a role.
+type RoleMembers struct {
+	base.ResourceBase
+
+	resData        config.Resource
+	SecurityClient consolev1alpha1grpc.SecurityServiceClient
+}
+
+// NewRoleMembers creates a new instance of the role members resource.
+func NewRoleMembers() *RoleMembers {
+	r := &RoleMembers{}
+	r.ResourceBase = base.NewResourceBase(
+		"redpanda_role_members",
+		ResourceRoleMembersSchema,
+		func(p config.Resource) { r.resData = p },
+	)
+	return r
+}
+
+// ResourceRoleMembersSchema returns the schema for the role members resource.
+func ResourceRoleMembersSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		MarkdownDescription: "Manages the set of principals assigned to an existing Redpanda role with a single membership update. Do not combine with `redpanda_role_assignment` for the same role.",
+		Attributes: map[string]schema.Attribute{
+			"role_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the role",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_api_url": schema.StringAttribute{
+				MarkdownDescription: "The cluster API URL. Changing this will prevent deletion of the resource on the existing cluster",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principals": schema.SetAttribute{
+				MarkdownDescription: "The principals that are members of the role. Use the Kafka-style prefixed form: `\"User:<name>\"` for an end user or `\"Group:<name>\"` for an IdP group.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(validators.PrincipalPrefix()),
+				},
+			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "When true, members of the role that are not listed in `principals` are reported as drift and removed on apply. When false (the default), only the listed principals are managed and other members are left untouched.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource. Same as `role_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create adds the declared principals to the role and, in authoritative mode,
+// removes every other member.
+func (r *RoleMembers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan models.RoleMembers
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RoleName
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the member set of the role.
+func (r *RoleMembers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state models.RoleMembers
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleName := state.RoleName.ValueString()
+	if err := r.createSecurityClient(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to create SecurityService client", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	current, found, err := listRoleMembers(ctx, r.SecurityClient, roleName)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list role members", utils.DeserializeGrpcError(err))
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, "Role not found, removing role members from state", map[string]any{"role_name": roleName})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// After import principals is null: adopt the whole member set so the
+	// first plan shows any difference with the configuration.
+	members := current
+	if !state.Principals.IsNull() && !state.Authoritative.ValueBool() {
+		var managed []string
+		resp.Diagnostics.Append(state.Principals.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		members = intersect(managed, current)
+	}
+
+	principals, diags := types.SetValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Principals = principals
+	state.ID = state.RoleName
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update applies the difference between the declared and actual member set in
+// a single membership update.
+func (r *RoleMembers) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state models.RoleMembers
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.RoleName
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the managed principals from the role. The role itself is
+// left in place.
+func (r *RoleMembers) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state models.RoleMembers
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(state.Principals.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createSecurityClient(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Failed to create SecurityService client", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	if err := updateRoleMembership(ctx, r.SecurityClient, state.RoleName.ValueString(), nil, managed); err != nil {
+		if isRoleNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Failed to remove role members", utils.DeserializeGrpcError(err))
+	}
+}
+
+// ImportState imports the member set of a role via
+// "<role_name>|<cluster_api_url>". The imported resource starts out with every
+// current member of the role.
+func (*RoleMembers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	roleName, clusterAPIURL, ok := strings.Cut(req.ID, "|")
+	if !ok || roleName == "" || clusterAPIURL == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format: <role_name>|<cluster_api_url> — e.g. developer|https://api.region.redpanda.com",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &models.RoleMembers{
+		RoleName:      types.StringValue(roleName),
+		ClusterAPIURL: types.StringValue(clusterAPIURL),
+		Principals:    types.SetNull(types.StringType),
+		Authoritative: types.BoolValue(false),
+		ID:            types.StringValue(roleName),
+	})...)
+}
+
+// reconcile lists the current members of the role and sends one
+// UpdateRoleMembership call that brings it in line with plan. prior is nil on
+// Create.
+func (r *RoleMembers) reconcile(ctx context.Context, plan, prior *models.RoleMembers) diag.Diagnostics {
+	var diags diag.Diagnostics
+	roleName := plan.RoleName.ValueString()
+
+	var desired, managed []string
+	diags.Append(plan.Principals.ElementsAs(ctx, &desired, false)...)
+	if prior != nil && !prior.Principals.IsNull() {
+		diags.Append(prior.Principals.ElementsAs(ctx, &managed, false)...)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.createSecurityClient(ctx, plan.ClusterAPIURL.ValueString()); err != nil {
+		diags.AddError("Failed to create SecurityService client", utils.DeserializeGrpcError(err))
+		return diags
+	}
+	current, found, err := listRoleMembers(ctx, r.SecurityClient, roleName)
+	if err != nil {
+		diags.AddError("Failed to list role members", utils.DeserializeGrpcError(err))
+		return diags
+	}
+	if !found {
+		diags.AddError("Role not found", fmt.Sprintf("role %q does not exist on the cluster; create it with redpanda_role first", roleName))
+		return diags
+	}
+
+	add, remove := membershipDelta(desired, current, managed, plan.Authoritative.ValueBool())
+	tflog.Info(ctx, "Updating role membership", map[string]any{
+		"role_name": roleName,
+		"add":       add,
+		"remove":    remove,
+	})
+	if err := updateRoleMembership(ctx, r.SecurityClient, roleName, add, remove); err != nil {
+		diags.AddError("Failed to update role membership", utils.DeserializeGrpcError(err))
+	}
+	return diags
+}
+
+// membershipDelta computes the principals to add to and remove from a role.
+// desired is the configured set, current the actual members, and managed the
+// set previously written to state. In authoritative mode every undeclared
+// member is removed; otherwise only principals that were managed before and
+// have since been dropped from the configuration are.
+func membershipDelta(desired, current, managed []string, authoritative bool) (add, remove []string) {
+	for _, p := range desired {
+		if !slices.Contains(current, p) {
+			add = append(add, p)
+		}
+	}
+	for _, p := range current {
+		if slices.Contains(desired, p) {
+			continue
+		}
+		if authoritative || slices.Contains(managed, p) {
+			remove = append(remove, p)
+		}
+	}
+	slices.Sort(add)
+	slices.Sort(remove)
+	return add, remove
+}
+
+// intersect returns the elements of a that are also in b.
+func intersect(a, b []string) []string {
+	out := make([]string, 0, len(a))
+	for _, p := range a {
+		if slices.Contains(b, p) {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// listRoleMembers pages through the members of a role. found is false when the
+// role does not exist.
+func listRoleMembers(ctx context.Context, cl consolev1alpha1grpc.SecurityServiceClient, roleName string) (members []string, found bool, err error) {
+	members = []string{}
+	pageToken := ""
+	for {
+		resp, err := cl.ListRoleMembers(ctx, &consolev1alpha1.ListRoleMembersRequest{
+			Request: &dataplanev1.ListRoleMembersRequest{RoleName: roleName, PageToken: pageToken},
+		})
+		if err != nil {
+			if isRoleNotFound(err) {
+				return nil, false, nil
+			}
+			return nil, false, fmt.Errorf("failed to list role members for role '%s': %w", roleName, err)
+		}
+		for _, m := range resp.GetResponse().GetMembers() {
+			members = append(members, m.GetPrincipal())
+		}
+		pageToken = resp.GetResponse().GetNextPageToken()
+		if pageToken == "" {
+			return members, true, nil
+		}
+	}
+}
+
+// updateRoleMembership sends a single UpdateRoleMembership call. It is a no-op
+// when there is nothing to add or remove.
+func updateRoleMembership(ctx context.Context, cl consolev1alpha1grpc.SecurityServiceClient, roleName string, add, remove []string) error {
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+	toMemberships := func(principals []string) []*dataplanev1.RoleMembership {
+		out := make([]*dataplanev1.RoleMembership, 0, len(principals))
+		for _, p := range principals {
+			out = append(out, &dataplanev1.RoleMembership{Principal: p})
+		}
+		return out
+	}
+	_, err := cl.UpdateRoleMembership(ctx, &consolev1alpha1.UpdateRoleMembershipRequest{
+		Request: &dataplanev1.UpdateRoleMembershipRequest{
+			RoleName: roleName,
+			Add:      toMemberships(add),
+			Remove:   toMemberships(remove),
+		},
+	})
+	return err
+}
+
+func isRoleNotFound(err error) bool {
+	return utils.IsNotFound(err) || strings.Contains(strings.ToLower(err.Error()), "unknown role")
+}
+
+// createSecurityClient creates a SecurityService client
+func (r *RoleMembers) createSecurityClient(ctx context.Context, clusterURL string) error {
+	if r.SecurityClient != nil {
+		return nil
+	}
+
+	if r.resData.DataplaneConnPool == nil {
+		return errors.New("provider not configured: dataplane connection pool is nil")
+	}
+	consoleURL := utils.SecurityServiceURL(ctx, r.CpCl, clusterURL)
+	conn, err := r.resData.DataplaneConnPool.GetCachedConnection(ctx, consoleURL)
+	if err != nil {
+		return fmt.Errorf("unable to open a connection with the console API at %s: %v", consoleURL, err)
+	}
+
+	r.SecurityClient = utils.CapabilityAware(clusterURL, r.CpCl, consolev1alpha1grpc.NewSecurityServiceClient)(conn)
+	return nil
+}
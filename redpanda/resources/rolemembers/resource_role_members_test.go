@@ -0,0 +1,159 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package rolemembers
+
+import (
+	"context"
+	"testing"
+
+	consolev1alpha1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/console/v1alpha1"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnit_RoleMembers_MembershipDelta(t *testing.T) {
+	tests := []struct {
+		name          string
+		desired       []string
+		current       []string
+		managed       []string
+		authoritative bool
+		wantAdd       []string
+		wantRemove    []string
+	}{
+		{
+			name:    "adds missing principals",
+			desired: []string{"User:bob", "User:alice"},
+			current: []string{"User:alice"},
+			wantAdd: []string{"User:bob"},
+		},
+		{
+			name:    "non-authoritative leaves unmanaged members",
+			desired: []string{"User:alice"},
+			current: []string{"User:alice", "User:eve"},
+		},
+		{
+			name:       "non-authoritative removes dropped managed members",
+			desired:    []string{"User:alice"},
+			current:    []string{"User:alice", "User:bob", "User:eve"},
+			managed:    []string{"User:alice", "User:bob"},
+			wantRemove: []string{"User:bob"},
+		},
+		{
+			name:          "authoritative removes undeclared members",
+			desired:       []string{"Group:eng", "User:alice"},
+			current:       []string{"User:eve", "User:alice", "User:bob"},
+			authoritative: true,
+			wantAdd:       []string{"Group:eng"},
+			wantRemove:    []string{"User:bob", "User:eve"},
+		},
+		{
+			name:          "authoritative empty set clears the role",
+			current:       []string{"User:alice"},
+			authoritative: true,
+			wantRemove:    []string{"User:alice"},
+		},
+		{
+			name:    "in sync",
+			desired: []string{"User:alice"},
+			current: []string{"User:alice"},
+			managed: []string{"User:alice"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := membershipDelta(tt.desired, tt.current, tt.managed, tt.authoritative)
+			assert.Equal(t, tt.wantAdd, add)
+			assert.Equal(t, tt.wantRemove, remove)
+		})
+	}
+}
+
+func TestUnit_RoleMembers_ListRoleMembers(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pages through members", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cl := mocks.NewMockSecurityServiceClient(ctrl)
+		gomock.InOrder(
+			cl.EXPECT().ListRoleMembers(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *consolev1alpha1.ListRoleMembersRequest, _ ...grpc.CallOption) (*consolev1alpha1.ListRoleMembersResponse, error) {
+					assert.Equal(t, "developer", req.GetRequest().GetRoleName())
+					assert.Empty(t, req.GetRequest().GetPageToken())
+					return &consolev1alpha1.ListRoleMembersResponse{Response: &dataplanev1.ListRoleMembersResponse{
+						Members:       []*dataplanev1.RoleMembership{{Principal: "User:alice"}},
+						NextPageToken: "next",
+					}}, nil
+				}),
+			cl.EXPECT().ListRoleMembers(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, req *consolev1alpha1.ListRoleMembersRequest, _ ...grpc.CallOption) (*consolev1alpha1.ListRoleMembersResponse, error) {
+					assert.Equal(t, "next", req.GetRequest().GetPageToken())
+					return &consolev1alpha1.ListRoleMembersResponse{Response: &dataplanev1.ListRoleMembersResponse{
+						Members: []*dataplanev1.RoleMembership{{Principal: "Group:eng"}},
+					}}, nil
+				}),
+		)
+
+		members, found, err := listRoleMembers(ctx, cl, "developer")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, []string{"User:alice", "Group:eng"}, members)
+	})
+
+	t.Run("missing role", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cl := mocks.NewMockSecurityServiceClient(ctrl)
+		cl.EXPECT().ListRoleMembers(gomock.Any(), gomock.Any()).
+			Return(nil, status.Error(codes.NotFound, "role not found"))
+
+		_, found, err := listRoleMembers(ctx, cl, "developer")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func TestUnit_RoleMembers_UpdateRoleMembership(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single call with add and remove", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cl := mocks.NewMockSecurityServiceClient(ctrl)
+		cl.EXPECT().UpdateRoleMembership(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *consolev1alpha1.UpdateRoleMembershipRequest, _ ...grpc.CallOption) (*consolev1alpha1.UpdateRoleMembershipResponse, error) {
+				r := req.GetRequest()
+				assert.Equal(t, "developer", r.GetRoleName())
+				require.Len(t, r.GetAdd(), 1)
+				assert.Equal(t, "User:bob", r.GetAdd()[0].GetPrincipal())
+				require.Len(t, r.GetRemove(), 1)
+				assert.Equal(t, "User:eve", r.GetRemove()[0].GetPrincipal())
+				return &consolev1alpha1.UpdateRoleMembershipResponse{}, nil
+			}).Times(1)
+
+		require.NoError(t, updateRoleMembership(ctx, cl, "developer", []string{"User:bob"}, []string{"User:eve"}))
+	})
+
+	t.Run("no-op without changes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cl := mocks.NewMockSecurityServiceClient(ctrl)
+		require.NoError(t, updateRoleMembership(ctx, cl, "developer", nil, nil))
+	})
+}
@@ -5,16 +5,19 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/kclients"
 	schemamodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 	"github.com/twmb/franz-go/pkg/sr"
 	"golang.org/x/oauth2"
 )
@@ -55,6 +58,13 @@ func DatasourceSchemaSchema(_ context.Context) schema.Schema {
 				Description: "The subject name for the schema.",
 				Required:    true,
 			},
+			"context": schema.StringAttribute{
+				Description: "The Schema Registry context the subject belongs to, without the leading dot (for example `tenant-a`). When omitted, the subject is read from the default context.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utils.SchemaRegistryContextPattern, "must be a context name without the leading dot or colons"),
+				},
+			},
 			"version": schema.Int64Attribute{
 				Description: "The version of the schema. If not specified, the latest version is used.",
 				Optional:    true,
@@ -92,6 +102,43 @@ func DatasourceSchemaSchema(_ context.Context) schema.Schema {
 					},
 				},
 			},
+			"metadata": schema.SingleNestedAttribute{
+				Description: "Data contract metadata attached to the schema version.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"properties": schema.MapAttribute{
+						Description: "Arbitrary key/value properties.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+					"tags": schema.MapAttribute{
+						Description: "Tags keyed by schema path.",
+						ElementType: types.ListType{ElemType: types.StringType},
+						Computed:    true,
+					},
+					"sensitive": schema.SetAttribute{
+						Description: "Names of properties whose values are sensitive.",
+						ElementType: types.StringType,
+						Computed:    true,
+					},
+				},
+			},
+			"rule_set": schema.SingleNestedAttribute{
+				Description: "Data contract rules that govern the schema.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"domain_rules": schema.ListNestedAttribute{
+						Description:  "Rules applied when serializing or deserializing records.",
+						Computed:     true,
+						NestedObject: schema.NestedAttributeObject{Attributes: datasourceRuleAttributes()},
+					},
+					"migration_rules": schema.ListNestedAttribute{
+						Description:  "Rules applied when records are read with a different schema version.",
+						Computed:     true,
+						NestedObject: schema.NestedAttributeObject{Attributes: datasourceRuleAttributes()},
+					},
+				},
+			},
 			"username": schema.StringAttribute{
 				Description: "SASL username for Schema Registry HTTP Basic authentication. Optional: when omitted (together with password) the provider authenticates using its cloud Bearer token.",
 				Optional:    true,
@@ -106,6 +153,24 @@ func DatasourceSchemaSchema(_ context.Context) schema.Schema {
 	}
 }
 
+// datasourceRuleAttributes returns the computed attributes of a single data
+// contract rule.
+func datasourceRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name":       schema.StringAttribute{Description: "User-defined name of the rule.", Computed: true},
+		"doc":        schema.StringAttribute{Description: "Description of the rule.", Computed: true},
+		"kind":       schema.StringAttribute{Description: "The kind of rule (TRANSFORM, CONDITION).", Computed: true},
+		"mode":       schema.StringAttribute{Description: "When the rule applies (UPGRADE, DOWNGRADE, UPDOWN, WRITE, READ, WRITEREAD).", Computed: true},
+		"type":       schema.StringAttribute{Description: "The rule executor, for example `CEL`.", Computed: true},
+		"tags":       schema.ListAttribute{Description: "Tags the rule applies to.", ElementType: types.StringType, Computed: true},
+		"params":     schema.MapAttribute{Description: "Parameters passed to the rule executor.", ElementType: types.StringType, Computed: true},
+		"expr":       schema.StringAttribute{Description: "The rule expression.", Computed: true},
+		"on_success": schema.StringAttribute{Description: "Action to run when the rule succeeds.", Computed: true},
+		"on_failure": schema.StringAttribute{Description: "Action to run when the rule fails.", Computed: true},
+		"disabled":   schema.BoolAttribute{Description: "Whether the rule is disabled.", Computed: true},
+	}
+}
+
 // getClient returns an SR client using the factory when set, else the default implementation.
 func (d *SchemaDataSource) getClient(ctx context.Context, clusterID, username, password string) (SRClienter, error) {
 	if d.clientFactory != nil {
@@ -127,7 +192,7 @@ func (d *SchemaDataSource) Read(ctx context.Context, request datasource.ReadRequ
 	}
 
 	clusterID := cfg.ClusterID.ValueString()
-	subject := cfg.Subject.ValueString()
+	subject := cfg.GetSubject()
 
 	client, err := d.getClient(ctx, clusterID, cfg.Username.ValueString(), cfg.Password.ValueString())
 	if err != nil {
@@ -160,6 +225,10 @@ func (d *SchemaDataSource) Read(ctx context.Context, request datasource.ReadRequ
 	refsList, refDiags := convertRefsToList(sub.References)
 	response.Diagnostics.Append(refDiags...)
 	cfg.References = refsList
+	cfg.Metadata = schemamodel.NewMetadata(sub.SchemaMetadata)
+	ruleSet, ruleDiags := schemamodel.NewRuleSet(sub.SchemaRuleSet)
+	response.Diagnostics.Append(ruleDiags...)
+	cfg.RuleSet = ruleSet
 
 	response.Diagnostics.Append(response.State.Set(ctx, &cfg)...)
 }
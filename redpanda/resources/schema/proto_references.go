@@ -0,0 +1,200 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	schemamodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/twmb/franz-go/pkg/sr"
+)
+
+// protoReferenceResolver turns the imports of a .proto body into schema
+// references. Each imported file is read from dir and registered under a
+// subject named after its import path (the Confluent convention), after its
+// own imports have been resolved the same way. Those subjects live in the
+// same Schema Registry context as the importing schema. Every file resolved
+// is recorded in deps, after the files it imports.
+type protoReferenceResolver struct {
+	client        SRClienter
+	dir           string
+	schemaContext string
+	registered    map[string]bool
+	resolved      map[string]sr.SchemaReference
+	visiting      map[string]bool
+	deps          []schemamodel.ProtoDependency
+}
+
+// resolveProtoReferences returns the references for the direct imports of
+// body, registering any imported file the registry does not already hold,
+// along with every file resolved on the way. registered holds the dependent
+// subjects an earlier apply created; they stay marked as created here.
+func resolveProtoReferences(ctx context.Context, client SRClienter, dir, schemaContext, body string, registered map[string]bool) ([]sr.SchemaReference, []schemamodel.ProtoDependency, error) {
+	r := &protoReferenceResolver{
+		client:        client,
+		dir:           dir,
+		schemaContext: schemaContext,
+		registered:    registered,
+		resolved:      map[string]sr.SchemaReference{},
+		visiting:      map[string]bool{},
+	}
+	refs, err := r.referencesFor(ctx, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	return refs, r.deps, nil
+}
+
+func (r *protoReferenceResolver) referencesFor(ctx context.Context, body string) ([]sr.SchemaReference, error) {
+	imports, err := schemamodel.ProtobufImports(body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse imports: %w", err)
+	}
+	refs := make([]sr.SchemaReference, 0, len(imports))
+	for _, imp := range imports {
+		ref, err := r.resolve(ctx, imp)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
+// resolve returns the reference for a single import path, depth-first.
+func (r *protoReferenceResolver) resolve(ctx context.Context, importPath string) (sr.SchemaReference, error) {
+	if ref, ok := r.resolved[importPath]; ok {
+		return ref, nil
+	}
+	if r.visiting[importPath] {
+		return sr.SchemaReference{}, fmt.Errorf("import cycle through %q", importPath)
+	}
+	r.visiting[importPath] = true
+	defer delete(r.visiting, importPath)
+
+	content, err := readProtoImport(r.dir, importPath)
+	if err != nil {
+		return sr.SchemaReference{}, err
+	}
+
+	refs, err := r.referencesFor(ctx, string(content))
+	if err != nil {
+		return sr.SchemaReference{}, fmt.Errorf("%s: %w", importPath, err)
+	}
+	dep := sr.Schema{
+		Schema:     string(content),
+		Type:       sr.TypeProtobuf,
+		References: refs,
+	}
+
+	// The import path doubles as the dependent subject name.
+	subject := utils.QualifySubject(r.schemaContext, importPath)
+	registered := r.registered[subject]
+	existing, err := r.client.LookupSchema(ctx, subject, dep)
+	if err != nil {
+		if !utils.IsNotFound(err) {
+			return sr.SchemaReference{}, fmt.Errorf("unable to look up subject %s: %w", subject, err)
+		}
+		tflog.Info(ctx, "Registering imported Protobuf file as a dependent subject", map[string]any{
+			"subject": subject,
+		})
+		existing, err = r.client.CreateSchema(ctx, subject, dep)
+		if err != nil {
+			return sr.SchemaReference{}, fmt.Errorf("unable to register subject %s: %w", subject, err)
+		}
+		// Version numbers are never reused within a subject, so a first
+		// version means the subject did not exist before this call.
+		registered = registered || existing.Version == 1
+	}
+
+	ref := sr.SchemaReference{Name: importPath, Subject: subject, Version: existing.Version}
+	r.resolved[importPath] = ref
+	r.deps = append(r.deps, schemamodel.ProtoDependency{
+		Name:       importPath,
+		Subject:    subject,
+		Version:    existing.Version,
+		SHA256:     protoSourceHash(content),
+		Registered: registered,
+	})
+	return ref, nil
+}
+
+// hashProtoImports returns the content hash of every file body imports,
+// directly or transitively, keyed by import path. It reads the same files
+// the resolver does without contacting the registry, so a plan can tell
+// whether an imported file changed since the last apply.
+func hashProtoImports(dir, body string) (map[string]string, error) {
+	hashes := map[string]string{}
+	visiting := map[string]bool{}
+	var walk func(body string) error
+	walk = func(body string) error {
+		imports, err := schemamodel.ProtobufImports(body)
+		if err != nil {
+			return fmt.Errorf("unable to parse imports: %w", err)
+		}
+		for _, imp := range imports {
+			if _, ok := hashes[imp]; ok {
+				continue
+			}
+			if visiting[imp] {
+				return fmt.Errorf("import cycle through %q", imp)
+			}
+			content, err := readProtoImport(dir, imp)
+			if err != nil {
+				return err
+			}
+			visiting[imp] = true
+			err = walk(string(content))
+			delete(visiting, imp)
+			if err != nil {
+				return fmt.Errorf("%s: %w", imp, err)
+			}
+			hashes[imp] = protoSourceHash(content)
+		}
+		return nil
+	}
+	if err := walk(body); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
+
+// readProtoImport reads an imported file from dir, refusing import paths
+// that escape it.
+func readProtoImport(dir, importPath string) ([]byte, error) {
+	file := filepath.Join(dir, filepath.FromSlash(importPath))
+	if rel, err := filepath.Rel(dir, file); err != nil || strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("import %q resolves outside proto_source_dir", importPath)
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read import %q: %w", importPath, err)
+	}
+	return content, nil
+}
+
+func protoSourceHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,336 @@
+// Copyright 2025 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	schemamodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/sr"
+	"go.uber.org/mock/gomock"
+)
+
+func writeProtoFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, body := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(body), 0o600))
+	}
+	return dir
+}
+
+func TestUnit_Schema_ResolveProtoReferences(t *testing.T) {
+	const money = `syntax = "proto3";
+package common;
+
+import "google/protobuf/timestamp.proto";
+
+message Money { int64 units = 1; google.protobuf.Timestamp at = 2; }
+`
+	const customer = `syntax = "proto3";
+package orders.v1;
+
+import "common/money.proto";
+
+message Customer { common.Money balance = 1; }
+`
+	const order = `syntax = "proto3";
+package orders.v1;
+
+import "common/money.proto";
+import "orders/v1/customer.proto";
+
+message Order { common.Money total = 1; Customer customer = 2; }
+`
+
+	t.Run("registers missing imports depth-first and reuses existing subjects", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+		dir := writeProtoFiles(t, map[string]string{
+			"common/money.proto":       money,
+			"orders/v1/customer.proto": customer,
+		})
+		client := mocks.NewMockSRClienter(ctrl)
+
+		moneySchema := sr.Schema{Schema: money, Type: sr.TypeProtobuf, References: []sr.SchemaReference{}}
+		gomock.InOrder(
+			client.EXPECT().LookupSchema(ctx, "common/money.proto", moneySchema).
+				Return(sr.SubjectSchema{}, errors.New("Subject 'common/money.proto' not found")),
+			client.EXPECT().CreateSchema(ctx, "common/money.proto", moneySchema).
+				Return(sr.SubjectSchema{Subject: "common/money.proto", Version: 1, ID: 10}, nil),
+			client.EXPECT().LookupSchema(ctx, "orders/v1/customer.proto", sr.Schema{
+				Schema:     customer,
+				Type:       sr.TypeProtobuf,
+				References: []sr.SchemaReference{{Name: "common/money.proto", Subject: "common/money.proto", Version: 1}},
+			}).Return(sr.SubjectSchema{Subject: "orders/v1/customer.proto", Version: 3, ID: 11}, nil),
+		)
+
+		refs, deps, err := resolveProtoReferences(ctx, client, dir, "", order, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []sr.SchemaReference{
+			{Name: "common/money.proto", Subject: "common/money.proto", Version: 1},
+			{Name: "orders/v1/customer.proto", Subject: "orders/v1/customer.proto", Version: 3},
+		}, refs)
+		assert.Equal(t, []schemamodel.ProtoDependency{
+			{Name: "common/money.proto", Subject: "common/money.proto", Version: 1, SHA256: protoSourceHash([]byte(money)), Registered: true},
+			{Name: "orders/v1/customer.proto", Subject: "orders/v1/customer.proto", Version: 3, SHA256: protoSourceHash([]byte(customer))},
+		}, deps, "only the subject created here is owned by the resource")
+	})
+
+	t.Run("subjects registered by an earlier apply stay owned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+		dir := writeProtoFiles(t, map[string]string{"common/money.proto": money})
+		client := mocks.NewMockSRClienter(ctrl)
+		client.EXPECT().LookupSchema(ctx, "common/money.proto", gomock.Any()).
+			Return(sr.SubjectSchema{}, errors.New("Subject 'common/money.proto' not found"))
+		client.EXPECT().CreateSchema(ctx, "common/money.proto", gomock.Any()).
+			Return(sr.SubjectSchema{Subject: "common/money.proto", Version: 2}, nil)
+
+		body := `syntax = "proto3"; import "common/money.proto"; message M {}`
+		_, deps, err := resolveProtoReferences(ctx, client, dir, "", body, map[string]bool{"common/money.proto": true})
+		require.NoError(t, err)
+		require.Len(t, deps, 1)
+		assert.Equal(t, 2, deps[0].Version)
+		assert.True(t, deps[0].Registered)
+	})
+
+	t.Run("dependent subjects are registered in the schema's context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+		dir := writeProtoFiles(t, map[string]string{"common/money.proto": money})
+		client := mocks.NewMockSRClienter(ctrl)
+		client.EXPECT().LookupSchema(ctx, ":.tenant-a:common/money.proto", gomock.Any()).
+			Return(sr.SubjectSchema{Subject: ":.tenant-a:common/money.proto", Version: 4}, nil)
+
+		refs, _, err := resolveProtoReferences(ctx, client, dir, "tenant-a", `syntax = "proto3"; import "common/money.proto"; message M {}`, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []sr.SchemaReference{
+			{Name: "common/money.proto", Subject: ":.tenant-a:common/money.proto", Version: 4},
+		}, refs)
+	})
+
+	t.Run("missing import file", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mocks.NewMockSRClienter(ctrl)
+
+		_, _, err := resolveProtoReferences(context.Background(), client, t.TempDir(), "", order, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `unable to read import "common/money.proto"`)
+	})
+
+	t.Run("import cycle", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mocks.NewMockSRClienter(ctrl)
+		dir := writeProtoFiles(t, map[string]string{
+			"a.proto": `syntax = "proto3"; import "b.proto"; message A {}`,
+			"b.proto": `syntax = "proto3"; import "a.proto"; message B {}`,
+		})
+
+		_, _, err := resolveProtoReferences(context.Background(), client, dir, "", `syntax = "proto3"; import "a.proto"; message M {}`, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "import cycle")
+	})
+
+	t.Run("import escaping the source directory", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		client := mocks.NewMockSRClienter(ctrl)
+
+		_, _, err := resolveProtoReferences(context.Background(), client, t.TempDir(), "", `syntax = "proto3"; import "../secret.proto"; message M {}`, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "outside proto_source_dir")
+	})
+
+	t.Run("lookup failure other than not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+		dir := writeProtoFiles(t, map[string]string{"common/money.proto": money})
+		client := mocks.NewMockSRClienter(ctrl)
+		client.EXPECT().LookupSchema(ctx, "common/money.proto", gomock.Any()).
+			Return(sr.SubjectSchema{}, errors.New("permission denied"))
+
+		_, _, err := resolveProtoReferences(ctx, client, dir, "", `syntax = "proto3"; import "common/money.proto"; message M {}`, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unable to look up subject common/money.proto")
+	})
+}
+
+func TestUnit_Schema_ResolveProtoSources(t *testing.T) {
+	t.Run("requires PROTOBUF schema type", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		model := &schemamodel.ResourceModel{
+			Subject:        types.StringValue("s"),
+			Schema:         types.StringValue(`{"type":"string"}`),
+			SchemaType:     types.StringValue("AVRO"),
+			ProtoSourceDir: types.StringValue(t.TempDir()),
+		}
+
+		diags := resolveProtoSources(context.Background(), mocks.NewMockSRClienter(ctrl), model, nil)
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "PROTOBUF")
+	})
+
+	t.Run("records resolved references", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		ctx := context.Background()
+		dir := writeProtoFiles(t, map[string]string{"shared/id.proto": `syntax = "proto3"; package shared; message ID { string v = 1; }`})
+		client := mocks.NewMockSRClienter(ctrl)
+		client.EXPECT().LookupSchema(ctx, "shared/id.proto", gomock.Any()).
+			Return(sr.SubjectSchema{Subject: "shared/id.proto", Version: 2}, nil)
+
+		model := &schemamodel.ResourceModel{
+			Subject:        types.StringValue("s"),
+			Schema:         types.StringValue(`syntax = "proto3"; import "shared/id.proto"; message M { shared.ID id = 1; }`),
+			SchemaType:     types.StringValue("PROTOBUF"),
+			ProtoSourceDir: types.StringValue(dir),
+			References:     types.ListNull(schemamodel.ReferenceObjectType),
+		}
+
+		diags := resolveProtoSources(ctx, client, model, nil)
+		require.False(t, diags.HasError(), "%v", diags)
+		require.Len(t, model.ResolvedReferences.Elements(), 1)
+		require.Len(t, model.GetProtoDependencies(), 1)
+		assert.False(t, model.GetProtoDependencies()[0].Registered, "an existing subject is not owned")
+		assert.Equal(t, []sr.SchemaReference{{Name: "shared/id.proto", Subject: "shared/id.proto", Version: 2}},
+			model.ToSchemaRequest().References, "resolved references must be sent with the schema")
+	})
+}
+
+func TestUnit_Schema_HashProtoImports(t *testing.T) {
+	dir := writeProtoFiles(t, map[string]string{
+		"a.proto": `syntax = "proto3"; import "b.proto"; message A {}`,
+		"b.proto": `syntax = "proto3"; message B {}`,
+	})
+
+	hashes, err := hashProtoImports(dir, `syntax = "proto3"; import "a.proto"; import "google/protobuf/empty.proto"; message M {}`)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"a.proto": protoSourceHash([]byte(`syntax = "proto3"; import "b.proto"; message A {}`)),
+		"b.proto": protoSourceHash([]byte(`syntax = "proto3"; message B {}`)),
+	}, hashes, "transitive imports are hashed and built-in imports skipped")
+
+	cyclic := writeProtoFiles(t, map[string]string{
+		"a.proto": `syntax = "proto3"; import "b.proto"; message A {}`,
+		"b.proto": `syntax = "proto3"; import "a.proto"; message B {}`,
+	})
+	_, err = hashProtoImports(cyclic, `syntax = "proto3"; import "a.proto"; message M {}`)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "import cycle")
+}
+
+func TestUnit_Schema_ModifyPlan_ProtoImports(t *testing.T) {
+	const (
+		body   = `syntax = "proto3"; import "shared/id.proto"; message M {}`
+		idV1   = `syntax = "proto3"; package shared; message ID { string v = 1; }`
+		idV2   = `syntax = "proto3"; package shared; message ID { string v = 1; string ns = 2; }`
+		shared = "shared/id.proto"
+	)
+
+	tests := []struct {
+		name        string
+		onDisk      string
+		wantUnknown bool
+	}{
+		{name: "unchanged import keeps the plan empty", onDisk: idV1},
+		{name: "changed import forces a new version", onDisk: idV2, wantUnknown: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			dir := writeProtoFiles(t, map[string]string{shared: tt.onDisk})
+			s := NewSchema()
+			schemaResp := resource.SchemaResponse{}
+			s.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			state := schemamodel.ResourceModel{
+				ClusterID:      types.StringValue("cluster-1"),
+				Subject:        types.StringValue("s"),
+				Schema:         types.StringValue(body),
+				SchemaType:     types.StringValue("PROTOBUF"),
+				ProtoSourceDir: types.StringValue(dir),
+				ID:             types.Int64Value(7),
+				Version:        types.Int64Value(1),
+				References:     types.ListNull(schemamodel.ReferenceObjectType),
+			}
+			require.False(t, state.SetResolvedReferences([]sr.SchemaReference{{Name: shared, Subject: shared, Version: 1}}).HasError())
+			require.False(t, state.SetProtoDependencies([]schemamodel.ProtoDependency{
+				{Name: shared, Subject: shared, Version: 1, SHA256: protoSourceHash([]byte(idV1)), Registered: true},
+			}).HasError())
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: schemaResp.Schema},
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+			}
+			require.False(t, req.State.Set(ctx, withNullComputed(state)).HasError())
+			require.False(t, req.Plan.Set(ctx, withNullComputed(state)).HasError())
+			resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+			s.ModifyPlan(ctx, req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var version types.Int64
+			var deps types.List
+			require.False(t, resp.Plan.GetAttribute(ctx, path.Root("version"), &version).HasError())
+			require.False(t, resp.Plan.GetAttribute(ctx, path.Root("proto_dependencies"), &deps).HasError())
+			assert.Equal(t, tt.wantUnknown, version.IsUnknown())
+			assert.Equal(t, tt.wantUnknown, deps.IsUnknown())
+		})
+	}
+}
+
+func TestUnit_Schema_Delete_ProtoDependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ctx := context.Background()
+	client := mocks.NewMockSRClienter(ctrl)
+
+	state := schemamodel.ResourceModel{
+		ClusterID:      types.StringValue("cluster-1"),
+		Subject:        types.StringValue("orders-value"),
+		SchemaType:     types.StringValue("PROTOBUF"),
+		ProtoSourceDir: types.StringValue(t.TempDir()),
+		DeleteMode:     types.StringValue("hard"),
+	}
+	require.False(t, state.SetProtoDependencies([]schemamodel.ProtoDependency{
+		{Name: "common/money.proto", Subject: "common/money.proto", Version: 1, Registered: true},
+		{Name: "shared/id.proto", Subject: "shared/id.proto", Version: 4},
+		{Name: "orders/v1/customer.proto", Subject: "orders/v1/customer.proto", Version: 1, Registered: true},
+	}).HasError())
+
+	gomock.InOrder(
+		client.EXPECT().DeleteSubject(ctx, "orders/v1/customer.proto", sr.SoftDelete).Return([]int{1}, nil),
+		client.EXPECT().DeleteSubject(ctx, "orders/v1/customer.proto", sr.HardDelete).Return([]int{1}, nil),
+		client.EXPECT().DeleteSubject(ctx, "common/money.proto", sr.SoftDelete).
+			Return(nil, errors.New("One or more references exist to the schema")),
+	)
+
+	diags := deleteProtoDependencies(ctx, client, &state)
+	require.False(t, diags.HasError(), "dependency cleanup must not fail the delete")
+	require.Len(t, diags.Warnings(), 1)
+	assert.Contains(t, diags.Warnings()[0].Detail(), "common/money.proto")
+}
@@ -4,10 +4,12 @@ package schema
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -26,6 +28,7 @@ var (
 	_ resource.Resource                = &Schema{}
 	_ resource.ResourceWithConfigure   = &Schema{}
 	_ resource.ResourceWithImportState = &Schema{}
+	_ resource.ResourceWithModifyPlan  = &Schema{}
 )
 
 // SRClienter defines the interface for Schema Registry client operations
@@ -33,7 +36,11 @@ type SRClienter interface {
 	CreateSchema(ctx context.Context, subject string, schema sr.Schema) (sr.SubjectSchema, error)
 	SchemaByVersion(ctx context.Context, subject string, version int) (sr.SubjectSchema, error)
 	Schemas(ctx context.Context, subject string) ([]sr.SubjectSchema, error)
+	LookupSchema(ctx context.Context, subject string, schema sr.Schema) (sr.SubjectSchema, error)
 	DeleteSubject(ctx context.Context, subject string, how sr.DeleteHow) ([]int, error)
+	SubjectVersions(ctx context.Context, subject string) ([]int, error)
+	DeleteSchema(ctx context.Context, subject string, version int, how sr.DeleteHow) error
+	SchemaReferences(ctx context.Context, subject string, version int) ([]sr.SubjectSchema, error)
 	SetCompatibility(ctx context.Context, c sr.SetCompatibility, subjects ...string) []sr.CompatibilityResult
 	Compatibility(ctx context.Context, subjects ...string) []sr.CompatibilityResult
 }
@@ -64,17 +71,20 @@ func NewSchema() *Schema {
 
 // importIDComponents holds the parsed components from an import ID string
 type importIDComponents struct {
-	clusterID string
-	subject   string
-	version   int64
-	username  string
-	password  string
+	clusterID     string
+	schemaContext string
+	subject       string
+	version       int64
+	username      string
+	password      string
 }
 
 // parseImportID parses the import ID string into its components. Two forms:
 //
 //	Bearer auth (default): cluster_id,subject,version
 //	Basic auth (optional): cluster_id,subject,version,username,password
+//
+// The subject may be context-qualified (`:.context:subject`).
 func parseImportID(importID string) (*importIDComponents, error) {
 	parts := strings.Split(importID, ",")
 	if len(parts) != 3 && len(parts) != 5 {
@@ -92,10 +102,12 @@ func parseImportID(importID string) (*importIDComponents, error) {
 		return nil, fmt.Errorf("version must be a valid integer: %w", err)
 	}
 
+	schemaContext, subject := utils.SplitQualifiedSubject(parts[1])
 	ret := &importIDComponents{
-		clusterID: parts[0],
-		subject:   parts[1],
-		version:   version,
+		clusterID:     parts[0],
+		schemaContext: schemaContext,
+		subject:       subject,
+		version:       version,
 	}
 	if len(parts) == 5 {
 		ret.username = parts[3]
@@ -109,6 +121,8 @@ func parseImportID(importID string) (*importIDComponents, error) {
 // Bearer auth (default): cluster_id,subject,version
 // Basic auth (optional): same 3 fields + ,username,password
 //
+// Subjects outside the default context are imported as :.context:subject.
+//
 // For Basic auth, password can also be set via REDPANDA_IMPORT_PASSWORD env var.
 func (*Schema) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	components, err := parseImportID(request.ID)
@@ -124,6 +138,9 @@ func (*Schema) ImportState(ctx context.Context, request resource.ImportStateRequ
 
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("cluster_id"), types.StringValue(components.clusterID))...)
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("subject"), types.StringValue(components.subject))...)
+	if components.schemaContext != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("context"), types.StringValue(components.schemaContext))...)
+	}
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("version"), types.Int64Value(components.version))...)
 	if components.username != "" {
 		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("username"), types.StringValue(components.username))...)
@@ -132,6 +149,52 @@ func (*Schema) ImportState(ctx context.Context, request resource.ImportStateRequ
 		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("password"), types.StringValue(password))...)
 	}
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("allow_deletion"), types.BoolValue(false))...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("delete_mode"), types.StringValue(schemamodel.DeleteModeSoft))...)
+}
+
+// ModifyPlan re-reads the files imported from proto_source_dir and, when any
+// of them changed since the last apply, marks the values Update will
+// recompute as unknown so the change shows up in the plan.
+func (*Schema) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+	var dir, body types.String
+	var prior types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("proto_source_dir"), &dir)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("schema"), &body)...)
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("proto_dependencies"), &prior)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if dir.IsNull() || dir.IsUnknown() || dir.ValueString() == "" || body.IsUnknown() {
+		return
+	}
+
+	hashes, err := hashProtoImports(dir.ValueString(), body.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			path.Root("proto_source_dir"),
+			"Failed to read Protobuf imports",
+			fmt.Sprintf("Unable to read the imports of the schema from %s: %v", dir.ValueString(), err),
+		)
+		return
+	}
+	recorded := map[string]string{}
+	for _, dep := range schemamodel.ParseProtoDependencies(prior) {
+		recorded[dep.Name] = dep.SHA256
+	}
+	if maps.Equal(hashes, recorded) {
+		return
+	}
+
+	tflog.Info(ctx, "Imported Protobuf files changed since the last apply", map[string]any{
+		"proto_source_dir": dir.ValueString(),
+	})
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("proto_dependencies"), types.ListUnknown(schemamodel.ProtoDependencyObjectType))...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("resolved_references"), types.ListUnknown(schemamodel.ReferenceObjectType))...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("id"), types.Int64Unknown())...)
 }
 
 // Create creates a new schema in the Schema Registry.
@@ -146,7 +209,8 @@ func (s *Schema) Create(ctx context.Context, request resource.CreateRequest, res
 	}
 	plan.PasswordWO = cfg.PasswordWO
 
-	client, err := s.getClient(ctx, plan.ClusterID.ValueString(), s.resData.TokenSource, plan.Username.ValueString(), plan.GetEffectivePassword())
+	srUsername, srPassword := plan.GetCredentials()
+	client, err := s.getClient(ctx, plan.ClusterID.ValueString(), s.resData.TokenSource, srUsername, srPassword)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Failed to create Schema Registry client",
@@ -155,35 +219,45 @@ func (s *Schema) Create(ctx context.Context, request resource.CreateRequest, res
 		return
 	}
 
-	schemaResp, err := client.CreateSchema(ctx, plan.Subject.ValueString(), plan.ToSchemaRequest())
+	if plan.UsesProtoSources() {
+		response.Diagnostics.Append(resolveProtoSources(ctx, client, &plan, nil)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.ProtoDependencies = types.ListNull(schemamodel.ProtoDependencyObjectType)
+	}
+
+	schemaResp, err := client.CreateSchema(ctx, plan.GetSubject(), plan.ToSchemaRequest())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Failed to create schema",
-			fmt.Sprintf("Unable to create schema for subject %s: %v", plan.Subject.ValueString(), err),
+			fmt.Sprintf("Unable to create schema for subject %s: %v", plan.GetSubject(), err),
 		)
 		return
 	}
 
 	plan.ID = types.Int64Value(int64(schemaResp.ID))
 	plan.Version = types.Int64Value(int64(schemaResp.Version))
+	response.Diagnostics.Append(plan.SetResolvedReferences(schemaResp.References)...)
 
 	// Set compatibility level if specified
 	if !plan.Compatibility.IsNull() && !plan.Compatibility.IsUnknown() {
-		err = setSubjectCompatibility(ctx, client, plan.Subject.ValueString(), plan.Compatibility.ValueString())
+		err = setSubjectCompatibility(ctx, client, plan.GetSubject(), plan.Compatibility.ValueString())
 		if err != nil {
 			response.Diagnostics.AddError(
 				"Failed to set compatibility level",
-				fmt.Sprintf("Unable to set compatibility level for subject %s: %v", plan.Subject.ValueString(), err),
+				fmt.Sprintf("Unable to set compatibility level for subject %s: %v", plan.GetSubject(), err),
 			)
 			return
 		}
 	} else {
 		// If compatibility is not specified, get the current compatibility level
-		compat, err := getCompatibility(ctx, client, plan.Subject.ValueString())
+		compat, err := getCompatibility(ctx, client, plan.GetSubject())
 		if err != nil {
 			response.Diagnostics.AddError(
 				"Failed to get compatibility level",
-				fmt.Sprintf("Unable to get compatibility level for subject %s: %v", plan.Subject.ValueString(), err),
+				fmt.Sprintf("Unable to get compatibility level for subject %s: %v", plan.GetSubject(), err),
 			)
 			return
 		}
@@ -206,7 +280,8 @@ func (s *Schema) Read(ctx context.Context, request resource.ReadRequest, respons
 	}
 
 	subject := state.GetSubject()
-	client, err := s.getClient(ctx, state.ClusterID.ValueString(), s.resData.TokenSource, state.Username.ValueString(), state.GetEffectivePassword())
+	srUsername, srPassword := state.GetCredentials()
+	client, err := s.getClient(ctx, state.ClusterID.ValueString(), s.resData.TokenSource, srUsername, srPassword)
 	if err != nil {
 		action, diags := utils.HandleGracefulRemoval(ctx, "schema", subject, state.AllowDeletion, err, "create schema registry client")
 		response.Diagnostics.Append(diags...)
@@ -240,11 +315,11 @@ func (s *Schema) Read(ctx context.Context, request resource.ReadRequest, respons
 	state.PasswordWOVersion = passwordWOVersion
 
 	// Get compatibility level for the subject
-	compat, err := getCompatibility(ctx, client, state.Subject.ValueString())
+	compat, err := getCompatibility(ctx, client, state.GetSubject())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Failed to get compatibility level",
-			fmt.Sprintf("Unable to get compatibility level for subject %s: %v", state.Subject.ValueString(), err),
+			fmt.Sprintf("Unable to get compatibility level for subject %s: %v", state.GetSubject(), err),
 		)
 		return
 	}
@@ -267,7 +342,8 @@ func (s *Schema) Update(ctx context.Context, request resource.UpdateRequest, res
 	}
 	plan.PasswordWO = cfg.PasswordWO
 
-	client, err := s.getClient(ctx, plan.ClusterID.ValueString(), s.resData.TokenSource, plan.Username.ValueString(), plan.GetEffectivePassword())
+	srUsername, srPassword := plan.GetCredentials()
+	client, err := s.getClient(ctx, plan.ClusterID.ValueString(), s.resData.TokenSource, srUsername, srPassword)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Failed to create Schema Registry client",
@@ -276,6 +352,15 @@ func (s *Schema) Update(ctx context.Context, request resource.UpdateRequest, res
 		return
 	}
 
+	if plan.UsesProtoSources() {
+		response.Diagnostics.Append(resolveProtoSources(ctx, client, &plan, state.GetProtoDependencies())...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.ProtoDependencies = types.ListNull(schemamodel.ProtoDependencyObjectType)
+	}
+
 	// Check if the schema has actually changed by comparing the normalized versions
 	planReq := plan.ToSchemaRequest()
 	stateReq := state.ToSchemaRequest()
@@ -298,9 +383,11 @@ func (s *Schema) Update(ctx context.Context, request resource.UpdateRequest, res
 		}
 	}
 
-	// Compare schema content, type, and references
+	// Compare schema content, type, data contract, and references
 	if bodiesEqual &&
 		planReq.Type == stateReq.Type &&
+		schemamodel.MetadataEquivalent(planReq.SchemaMetadata, stateReq.SchemaMetadata) &&
+		schemamodel.RuleSetEquivalent(planReq.SchemaRuleSet, stateReq.SchemaRuleSet) &&
 		len(planReq.References) == len(stateReq.References) {
 		// Check if references are identical
 		referencesEqual := true
@@ -320,42 +407,43 @@ func (s *Schema) Update(ctx context.Context, request resource.UpdateRequest, res
 				ID:      int(state.ID.ValueInt64()),
 				Version: int(state.Version.ValueInt64()),
 				Schema:  planReq,
-				Subject: state.Subject.ValueString(),
+				Subject: state.GetSubject(),
 			})...)
 
 			// Check if only compatibility changed
 			if plan.Compatibility.ValueString() != state.Compatibility.ValueString() && !plan.Compatibility.IsNull() && !plan.Compatibility.IsUnknown() {
-				err = setSubjectCompatibility(ctx, client, plan.Subject.ValueString(), plan.Compatibility.ValueString())
+				err = setSubjectCompatibility(ctx, client, plan.GetSubject(), plan.Compatibility.ValueString())
 				if err != nil {
 					response.Diagnostics.AddError(
 						"Failed to update compatibility level",
-						fmt.Sprintf("Unable to update compatibility level for subject %s: %v", plan.Subject.ValueString(), err),
+						fmt.Sprintf("Unable to update compatibility level for subject %s: %v", plan.GetSubject(), err),
 					)
 					return
 				}
 			}
 			// Retrieve the current compatibility value
-			compat, err := getCompatibility(ctx, client, plan.Subject.ValueString())
+			compat, err := getCompatibility(ctx, client, plan.GetSubject())
 			if err != nil {
 				response.Diagnostics.AddError(
 					"Failed to get compatibility level",
-					fmt.Sprintf("Unable to get compatibility level for subject %s: %v", plan.Subject.ValueString(), err),
+					fmt.Sprintf("Unable to get compatibility level for subject %s: %v", plan.GetSubject(), err),
 				)
 				return
 			}
 			plan.Compatibility = compat
 
+			response.Diagnostics.Append(pruneVersions(ctx, client, &plan)...)
 			response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 			return
 		}
 	}
 
 	// Schema has changed, create new version
-	schemaResp, err := client.CreateSchema(ctx, plan.Subject.ValueString(), planReq)
+	schemaResp, err := client.CreateSchema(ctx, plan.GetSubject(), planReq)
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Failed to update schema",
-			fmt.Sprintf("Unable to create new version of schema for subject %s: %v", plan.Subject.ValueString(), err),
+			fmt.Sprintf("Unable to create new version of schema for subject %s: %v", plan.GetSubject(), err),
 		)
 		return
 	}
@@ -364,26 +452,27 @@ func (s *Schema) Update(ctx context.Context, request resource.UpdateRequest, res
 
 	// Update compatibility level if it changed
 	if plan.Compatibility.ValueString() != state.Compatibility.ValueString() && !plan.Compatibility.IsNull() && !plan.Compatibility.IsUnknown() {
-		err = setSubjectCompatibility(ctx, client, plan.Subject.ValueString(), plan.Compatibility.ValueString())
+		err = setSubjectCompatibility(ctx, client, plan.GetSubject(), plan.Compatibility.ValueString())
 		if err != nil {
 			response.Diagnostics.AddError(
 				"Failed to update compatibility level",
-				fmt.Sprintf("Unable to update compatibility level for subject %s: %v", plan.Subject.ValueString(), err),
+				fmt.Sprintf("Unable to update compatibility level for subject %s: %v", plan.GetSubject(), err),
 			)
 			return
 		}
 	}
 	// Retrieve the current compatibility value
-	compat, err := getCompatibility(ctx, client, plan.Subject.ValueString())
+	compat, err := getCompatibility(ctx, client, plan.GetSubject())
 	if err != nil {
 		response.Diagnostics.AddError(
 			"Failed to get compatibility level",
-			fmt.Sprintf("Unable to get compatibility level for subject %s: %v", plan.Subject.ValueString(), err),
+			fmt.Sprintf("Unable to get compatibility level for subject %s: %v", plan.GetSubject(), err),
 		)
 		return
 	}
 	plan.Compatibility = compat
 
+	response.Diagnostics.Append(pruneVersions(ctx, client, &plan)...)
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
@@ -395,7 +484,8 @@ func (s *Schema) Delete(ctx context.Context, request resource.DeleteRequest, res
 		return
 	}
 
-	client, err := s.getClient(ctx, state.ClusterID.ValueString(), s.resData.TokenSource, state.Username.ValueString(), state.GetEffectivePassword())
+	srUsername, srPassword := state.GetCredentials()
+	client, err := s.getClient(ctx, state.ClusterID.ValueString(), s.resData.TokenSource, srUsername, srPassword)
 	if err != nil {
 		if utils.IsPermissionDenied(err) || utils.IsClusterUnreachable(err) {
 			if !state.AllowDeletion.IsNull() && !state.AllowDeletion.ValueBool() {
@@ -420,7 +510,7 @@ func (s *Schema) Delete(ctx context.Context, request resource.DeleteRequest, res
 		return
 	}
 
-	_, err = client.DeleteSubject(ctx, state.GetSubject(), sr.SoftDelete)
+	err = deleteSubject(ctx, client, state.GetSubject(), state.IsHardDelete())
 	if err != nil {
 		if !utils.IsNotFound(err) {
 			if utils.IsClusterUnreachable(err) || utils.IsPermissionDenied(err) {
@@ -446,6 +536,7 @@ func (s *Schema) Delete(ctx context.Context, request resource.DeleteRequest, res
 			return
 		}
 	}
+	response.Diagnostics.Append(deleteProtoDependencies(ctx, client, &state)...)
 	response.State.RemoveResource(ctx)
 }
 
@@ -464,6 +555,141 @@ func (s *Schema) getClient(ctx context.Context, clusterID string, ts oauth2.Toke
 	return newSchemaRegistryClientWrapper(client), nil
 }
 
+// deleteSubject deletes every version of subject. A hard delete is preceded
+// by the soft delete Schema Registry requires; a subject that is already soft
+// deleted is still hard deleted.
+func deleteSubject(ctx context.Context, client SRClienter, subject string, hard bool) error {
+	_, err := client.DeleteSubject(ctx, subject, sr.SoftDelete)
+	if err != nil && (!hard || !utils.IsNotFound(err)) {
+		return err
+	}
+	if !hard {
+		return nil
+	}
+	_, err = client.DeleteSubject(ctx, subject, sr.HardDelete)
+	return err
+}
+
+// pruneVersions deletes all but the newest keep_versions versions of the
+// subject, using the configured delete_mode. Versions still referenced by
+// other subjects are kept, and so is the model's version along with anything
+// newer: CreateSchema returns the existing version when identical content is
+// registered again, which may be older than the subject's latest. Failures
+// are reported as warnings: the new version is already registered and must be
+// recorded in state.
+func pruneVersions(ctx context.Context, client SRClienter, model *schemamodel.ResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.KeepVersions.IsNull() || model.KeepVersions.IsUnknown() {
+		return diags
+	}
+	subject := model.GetSubject()
+	keep := int(model.KeepVersions.ValueInt64())
+
+	versions, err := client.SubjectVersions(ctx, subject)
+	if err != nil {
+		diags.AddWarning(
+			"Failed to prune schema versions",
+			fmt.Sprintf("Unable to list versions of subject %s: %v", subject, err),
+		)
+		return diags
+	}
+	if len(versions) <= keep {
+		return diags
+	}
+
+	current := int(model.Version.ValueInt64())
+	for _, version := range versions[:len(versions)-keep] {
+		if version >= current {
+			continue
+		}
+		referrers, err := client.SchemaReferences(ctx, subject, version)
+		if err != nil {
+			diags.AddWarning(
+				"Failed to prune schema versions",
+				fmt.Sprintf("Unable to check references to version %d of subject %s: %v", version, subject, err),
+			)
+			return diags
+		}
+		if len(referrers) > 0 {
+			tflog.Info(ctx, "Keeping schema version referenced by other subjects", map[string]any{
+				"subject":       subject,
+				"version":       version,
+				"referenced_by": referrers[0].Subject,
+			})
+			continue
+		}
+
+		err = client.DeleteSchema(ctx, subject, version, sr.SoftDelete)
+		if err == nil && model.IsHardDelete() {
+			err = client.DeleteSchema(ctx, subject, version, sr.HardDelete)
+		}
+		if err != nil {
+			diags.AddWarning(
+				"Failed to prune schema versions",
+				fmt.Sprintf("Unable to delete version %d of subject %s: %v", version, subject, err),
+			)
+			return diags
+		}
+	}
+	return diags
+}
+
+// resolveProtoSources resolves the imports of a proto_source_dir schema and
+// records them as the model's resolved references and proto dependencies.
+// prior is the previous proto_dependencies, so subjects an earlier apply
+// registered stay owned by the resource.
+func resolveProtoSources(ctx context.Context, client SRClienter, model *schemamodel.ResourceModel, prior []schemamodel.ProtoDependency) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !strings.EqualFold(model.SchemaType.ValueString(), "PROTOBUF") {
+		diags.AddAttributeError(
+			path.Root("proto_source_dir"),
+			"Invalid proto_source_dir",
+			"proto_source_dir can only be used with schema_type = \"PROTOBUF\".",
+		)
+		return diags
+	}
+
+	registered := map[string]bool{}
+	for _, dep := range prior {
+		if dep.Registered {
+			registered[dep.Subject] = true
+		}
+	}
+	refs, deps, err := resolveProtoReferences(ctx, client, model.ProtoSourceDir.ValueString(), model.Context.ValueString(), model.Schema.ValueString(), registered)
+	if err != nil {
+		diags.AddError(
+			"Failed to resolve Protobuf imports",
+			fmt.Sprintf("Unable to resolve the imports of subject %s from %s: %v", model.GetSubject(), model.ProtoSourceDir.ValueString(), err),
+		)
+		return diags
+	}
+	diags.Append(model.SetResolvedReferences(refs)...)
+	diags.Append(model.SetProtoDependencies(deps)...)
+	return diags
+}
+
+// deleteProtoDependencies deletes the dependent subjects the resource
+// registered, importers first. A subject still referenced by another schema
+// is left in place; failures are warnings because the schema itself is
+// already gone.
+func deleteProtoDependencies(ctx context.Context, client SRClienter, model *schemamodel.ResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	deps := model.GetProtoDependencies()
+	for i := len(deps) - 1; i >= 0; i-- {
+		dep := deps[i]
+		if !dep.Registered {
+			continue
+		}
+		if err := deleteSubject(ctx, client, dep.Subject, model.IsHardDelete()); err != nil && !utils.IsNotFound(err) {
+			diags.AddWarning(
+				"Failed to delete dependent subject",
+				fmt.Sprintf("Unable to delete subject %s, registered from %s: %v", dep.Subject, dep.Name, err),
+			)
+		}
+	}
+	return diags
+}
+
 // fetchSchema fetches a schema by subject and optional version
 func fetchSchema(ctx context.Context, client SRClienter, subject string, version *int) (sr.SubjectSchema, error) {
 	if version != nil {
@@ -40,11 +40,28 @@ import (
 
 // setConfig populates a tfsdk.Config using a temporary State (since Config has no Set method).
 func setConfig(ctx context.Context, s rsschema.Schema, val any) (tfsdk.Config, diag.Diagnostics) {
+	if m, ok := val.(*schemamodel.ResourceModel); ok {
+		val = withNullComputed(*m)
+	}
 	tmp := tfsdk.State{Schema: s}
 	diags := tmp.Set(ctx, val)
 	return tfsdk.Config{Schema: s, Raw: tmp.Raw}, diags
 }
 
+// withNullComputed returns a copy of m with the computed reference lists the
+// fixtures leave unset defaulted to null, since a zero-value types.List
+// cannot be written to a plan or state.
+func withNullComputed(m schemamodel.ResourceModel) *schemamodel.ResourceModel {
+	ctx := context.Background()
+	if m.ResolvedReferences.ElementType(ctx) == nil {
+		m.ResolvedReferences = types.ListNull(schemamodel.ReferenceObjectType)
+	}
+	if m.ProtoDependencies.ElementType(ctx) == nil {
+		m.ProtoDependencies = types.ListNull(schemamodel.ProtoDependencyObjectType)
+	}
+	return &m
+}
+
 func TestUnit_Schema_ParseImportID(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -363,6 +380,16 @@ func TestUnit_Schema_ParseImportID(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "valid import - context-qualified subject",
+			importID: "cluster-1,:.tenant-a:orders-value,3",
+			want: &importIDComponents{
+				clusterID:     "cluster-1",
+				schemaContext: "tenant-a",
+				subject:       "orders-value",
+				version:       3,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -382,6 +409,7 @@ func TestUnit_Schema_ParseImportID(t *testing.T) {
 			require.NotNil(t, got, "result should not be nil")
 
 			assert.Equal(t, tt.want.clusterID, got.clusterID, "clusterID should match")
+			assert.Equal(t, tt.want.schemaContext, got.schemaContext, "schemaContext should match")
 			assert.Equal(t, tt.want.subject, got.subject, "subject should match")
 			assert.Equal(t, tt.want.version, got.version, "version should match")
 			assert.Equal(t, tt.want.username, got.username, "username should match")
@@ -880,7 +908,7 @@ func TestUnit_Schema_Create(t *testing.T) {
 			req := resource.CreateRequest{
 				Plan: tfsdk.Plan{Schema: schemaResp.Schema},
 			}
-			diags := req.Plan.Set(ctx, &tt.input)
+			diags := req.Plan.Set(ctx, withNullComputed(tt.input))
 			require.False(t, diags.HasError(), "Plan.Set should not error")
 			if tt.config != nil {
 				req.Config, diags = setConfig(ctx, schemaResp.Schema, tt.config)
@@ -1361,7 +1389,7 @@ func TestUnit_Schema_CreateReadConsistency(t *testing.T) {
 			createReq := resource.CreateRequest{
 				Plan: tfsdk.Plan{Schema: schemaResp.Schema},
 			}
-			diags := createReq.Plan.Set(ctx, &tt.input)
+			diags := createReq.Plan.Set(ctx, withNullComputed(tt.input))
 			require.False(t, diags.HasError(), "Plan.Set should not error")
 			createReq.Config, diags = setConfig(ctx, schemaResp.Schema, &tt.input)
 			require.False(t, diags.HasError(), "Config set should not error")
@@ -1775,7 +1803,7 @@ func TestUnit_Schema_Read(t *testing.T) {
 			req := resource.ReadRequest{
 				State: tfsdk.State{Schema: schemaResp.Schema},
 			}
-			diags := req.State.Set(ctx, &tt.initialState)
+			diags := req.State.Set(ctx, withNullComputed(tt.initialState))
 			require.False(t, diags.HasError(), "State.Set should not error")
 
 			resp := resource.ReadResponse{
@@ -1983,9 +2011,9 @@ func TestUnit_Schema_Update(t *testing.T) {
 				State: tfsdk.State{Schema: schemaResp.Schema},
 				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
 			}
-			diags := req.State.Set(ctx, &tt.initialState)
+			diags := req.State.Set(ctx, withNullComputed(tt.initialState))
 			require.False(t, diags.HasError(), "State.Set should not error")
-			diags = req.Plan.Set(ctx, &tt.plan)
+			diags = req.Plan.Set(ctx, withNullComputed(tt.plan))
 			require.False(t, diags.HasError(), "Plan.Set should not error")
 			req.Config, diags = setConfig(ctx, schemaResp.Schema, &tt.plan)
 			require.False(t, diags.HasError(), "Config set should not error")
@@ -2507,9 +2535,9 @@ func TestUnit_Schema_UpdateReadConsistency(t *testing.T) {
 				State: tfsdk.State{Schema: schemaResp.Schema},
 				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
 			}
-			diags := updateReq.State.Set(ctx, &tt.initialState)
+			diags := updateReq.State.Set(ctx, withNullComputed(tt.initialState))
 			require.False(t, diags.HasError(), "State.Set should not error")
-			diags = updateReq.Plan.Set(ctx, &tt.plan)
+			diags = updateReq.Plan.Set(ctx, withNullComputed(tt.plan))
 			require.False(t, diags.HasError(), "Plan.Set should not error")
 			updateReq.Config, diags = setConfig(ctx, schemaResp.Schema, &tt.plan)
 			require.False(t, diags.HasError(), "Config set should not error")
@@ -2606,6 +2634,19 @@ func TestUnit_Schema_Delete(t *testing.T) {
 				References:    types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "subject": types.StringType, "version": types.Int64Type}}),
 			},
 		},
+		{
+			name: "deletion with delete_mode=hard",
+			initialState: schemamodel.ResourceModel{
+				ID:         types.Int64Value(4),
+				ClusterID:  types.StringValue("cluster-1"),
+				Subject:    types.StringValue("test-subject"),
+				Version:    types.Int64Value(1),
+				DeleteMode: types.StringValue("hard"),
+				Username:   types.StringValue("user"),
+				Password:   types.StringValue("pass"),
+				References: types.ListNull(types.ObjectType{AttrTypes: map[string]attr.Type{"name": types.StringType, "subject": types.StringType, "version": types.Int64Type}}),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2616,9 +2657,15 @@ func TestUnit_Schema_Delete(t *testing.T) {
 			ctx := context.Background()
 			mockClient := mocks.NewMockSRClienter(ctrl)
 
-			mockClient.EXPECT().
+			softDelete := mockClient.EXPECT().
 				DeleteSubject(ctx, tt.initialState.Subject.ValueString(), sr.SoftDelete).
 				Return([]int{int(tt.initialState.Version.ValueInt64())}, nil)
+			if tt.initialState.IsHardDelete() {
+				mockClient.EXPECT().
+					DeleteSubject(ctx, tt.initialState.Subject.ValueString(), sr.HardDelete).
+					Return([]int{int(tt.initialState.Version.ValueInt64())}, nil).
+					After(softDelete)
+			}
 
 			s := &Schema{
 				clientFactory: func(_ context.Context, _ *cloud.ControlPlaneClientSet, _ string, _ oauth2.TokenSource, _, _ string) (SRClienter, error) {
@@ -2632,7 +2679,7 @@ func TestUnit_Schema_Delete(t *testing.T) {
 			req := resource.DeleteRequest{
 				State: tfsdk.State{Schema: schemaResp.Schema},
 			}
-			diags := req.State.Set(ctx, &tt.initialState)
+			diags := req.State.Set(ctx, withNullComputed(tt.initialState))
 			require.False(t, diags.HasError(), "State.Set should not error")
 
 			resp := resource.DeleteResponse{
@@ -2655,6 +2702,107 @@ func TestUnit_Schema_Delete(t *testing.T) {
 	}
 }
 
+func TestUnit_Schema_PruneVersions(t *testing.T) {
+	const subject = "test-subject"
+
+	tests := []struct {
+		name         string
+		keepVersions types.Int64
+		version      int64
+		deleteMode   string
+		setup        func(ctx context.Context, m *mocks.MockSRClienter)
+		wantWarning  bool
+	}{
+		{
+			name:         "keep_versions unset leaves history alone",
+			keepVersions: types.Int64Null(),
+			setup:        func(context.Context, *mocks.MockSRClienter) {},
+		},
+		{
+			name:         "nothing to prune",
+			keepVersions: types.Int64Value(3),
+			version:      3,
+			setup: func(ctx context.Context, m *mocks.MockSRClienter) {
+				m.EXPECT().SubjectVersions(ctx, subject).Return([]int{1, 2, 3}, nil)
+			},
+		},
+		{
+			name:         "referenced versions are kept",
+			keepVersions: types.Int64Value(1),
+			version:      3,
+			deleteMode:   "soft",
+			setup: func(ctx context.Context, m *mocks.MockSRClienter) {
+				m.EXPECT().SubjectVersions(ctx, subject).Return([]int{1, 2, 3}, nil)
+				m.EXPECT().SchemaReferences(ctx, subject, 1).Return([]sr.SubjectSchema{{Subject: "orders"}}, nil)
+				m.EXPECT().SchemaReferences(ctx, subject, 2).Return(nil, nil)
+				m.EXPECT().DeleteSchema(ctx, subject, 2, sr.SoftDelete).Return(nil)
+			},
+		},
+		{
+			name:         "hard delete mode purges pruned versions",
+			keepVersions: types.Int64Value(2),
+			version:      6,
+			deleteMode:   "hard",
+			setup: func(ctx context.Context, m *mocks.MockSRClienter) {
+				m.EXPECT().SubjectVersions(ctx, subject).Return([]int{4, 5, 6}, nil)
+				m.EXPECT().SchemaReferences(ctx, subject, 4).Return(nil, nil)
+				gomock.InOrder(
+					m.EXPECT().DeleteSchema(ctx, subject, 4, sr.SoftDelete).Return(nil),
+					m.EXPECT().DeleteSchema(ctx, subject, 4, sr.HardDelete).Return(nil),
+				)
+			},
+		},
+		{
+			// Registering content identical to version 2 makes CreateSchema
+			// return 2 rather than a new version; it is in state and must
+			// survive, as must the newer version 3.
+			name:         "version returned by CreateSchema is kept when older than latest",
+			keepVersions: types.Int64Value(1),
+			version:      2,
+			deleteMode:   "hard",
+			setup: func(ctx context.Context, m *mocks.MockSRClienter) {
+				m.EXPECT().SubjectVersions(ctx, subject).Return([]int{1, 2, 3}, nil)
+				m.EXPECT().SchemaReferences(ctx, subject, 1).Return(nil, nil)
+				gomock.InOrder(
+					m.EXPECT().DeleteSchema(ctx, subject, 1, sr.SoftDelete).Return(nil),
+					m.EXPECT().DeleteSchema(ctx, subject, 1, sr.HardDelete).Return(nil),
+				)
+			},
+		},
+		{
+			name:         "delete failure is a warning",
+			keepVersions: types.Int64Value(1),
+			version:      2,
+			deleteMode:   "soft",
+			setup: func(ctx context.Context, m *mocks.MockSRClienter) {
+				m.EXPECT().SubjectVersions(ctx, subject).Return([]int{1, 2}, nil)
+				m.EXPECT().SchemaReferences(ctx, subject, 1).Return(nil, nil)
+				m.EXPECT().DeleteSchema(ctx, subject, 1, sr.SoftDelete).Return(errors.New("forbidden"))
+			},
+			wantWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ctx := context.Background()
+			mockClient := mocks.NewMockSRClienter(ctrl)
+			tt.setup(ctx, mockClient)
+
+			model := &schemamodel.ResourceModel{
+				Subject:      types.StringValue(subject),
+				KeepVersions: tt.keepVersions,
+				Version:      types.Int64Value(tt.version),
+				DeleteMode:   types.StringValue(tt.deleteMode),
+			}
+			diags := pruneVersions(ctx, mockClient, model)
+			require.False(t, diags.HasError(), "pruning must never fail the apply")
+			assert.Equal(t, tt.wantWarning, diags.WarningsCount() > 0)
+		})
+	}
+}
+
 func TestUnit_SchemaDataSource_Read(t *testing.T) {
 	refAttrTypes := map[string]attr.Type{
 		"name":    types.StringType,
@@ -2676,6 +2824,8 @@ func TestUnit_SchemaDataSource_Read(t *testing.T) {
 		wantSchema         string
 		wantSchemaType     string
 		wantRefs           int
+		wantProperties     map[string]string
+		wantDomainRules    int
 		wantUsername       string
 		wantPassword       string
 	}{
@@ -2747,6 +2897,45 @@ func TestUnit_SchemaDataSource_Read(t *testing.T) {
 			wantSchemaType: "AVRO",
 			wantRefs:       1,
 		},
+		{
+			name: "schema with data contract metadata and rules",
+			inputCfg: schemamodel.DataModel{
+				ClusterID:  types.StringValue("cluster-1"),
+				Subject:    types.StringValue("contract-subject"),
+				Version:    types.Int64Null(),
+				References: types.ListNull(types.ObjectType{AttrTypes: refAttrTypes}),
+			},
+			mockSchemas: []sr.SubjectSchema{
+				{
+					ID:      6,
+					Version: 1,
+					Schema: sr.Schema{
+						Schema: `{"type":"record","name":"User"}`,
+						Type:   sr.TypeAvro,
+						SchemaMetadata: &sr.SchemaMetadata{
+							Properties: map[string]string{"owner": "governance"},
+							Tags:       map[string][]string{"User.email": {"PII"}},
+						},
+						SchemaRuleSet: &sr.SchemaRuleSet{
+							DomainRules: []sr.SchemaRule{{
+								Name: "checkEmail",
+								Kind: sr.SchemaRuleKindCondition,
+								Mode: sr.SchemaRuleModeWrite,
+								Type: "CEL",
+								Expr: "message.email != ''",
+							}},
+						},
+					},
+					Subject: "contract-subject",
+				},
+			},
+			wantID:          6,
+			wantVersion:     1,
+			wantSchema:      `{"type":"record","name":"User"}`,
+			wantSchemaType:  "AVRO",
+			wantProperties:  map[string]string{"owner": "governance"},
+			wantDomainRules: 1,
+		},
 		{
 			name: "client factory error surfaced",
 			inputCfg: schemamodel.DataModel{
@@ -2906,6 +3095,19 @@ func TestUnit_SchemaDataSource_Read(t *testing.T) {
 			} else {
 				assert.Equal(t, tt.wantRefs, len(state.References.Elements()))
 			}
+			if tt.wantProperties == nil {
+				assert.Nil(t, state.Metadata, "expected null metadata")
+			} else {
+				require.NotNil(t, state.Metadata)
+				assert.Equal(t, tt.wantProperties, state.Metadata.ToSR().Properties)
+			}
+			if tt.wantDomainRules == 0 {
+				assert.Nil(t, state.RuleSet, "expected null rule_set")
+			} else {
+				require.NotNil(t, state.RuleSet)
+				assert.Equal(t, tt.wantDomainRules, len(state.RuleSet.DomainRules.Elements()))
+				assert.True(t, state.RuleSet.MigrationRules.IsNull())
+			}
 			if tt.wantUsername != "" || tt.wantPassword != "" {
 				assert.Equal(t, tt.wantUsername, capturedUsername, "username should flow to client factory")
 				assert.Equal(t, tt.wantPassword, capturedPassword, "password should flow to client factory")
@@ -43,10 +43,26 @@ func (w *schemaRegistryClientWrapper) Schemas(ctx context.Context, subject strin
 	return w.client.Schemas(ctx, subject)
 }
 
+func (w *schemaRegistryClientWrapper) LookupSchema(ctx context.Context, subject string, schema sr.Schema) (sr.SubjectSchema, error) {
+	return w.client.LookupSchema(ctx, subject, schema)
+}
+
 func (w *schemaRegistryClientWrapper) DeleteSubject(ctx context.Context, subject string, how sr.DeleteHow) ([]int, error) {
 	return w.client.DeleteSubject(ctx, subject, how)
 }
 
+func (w *schemaRegistryClientWrapper) SubjectVersions(ctx context.Context, subject string) ([]int, error) {
+	return w.client.SubjectVersions(ctx, subject)
+}
+
+func (w *schemaRegistryClientWrapper) DeleteSchema(ctx context.Context, subject string, version int, how sr.DeleteHow) error {
+	return w.client.DeleteSchema(ctx, subject, version, how)
+}
+
+func (w *schemaRegistryClientWrapper) SchemaReferences(ctx context.Context, subject string, version int) ([]sr.SubjectSchema, error) {
+	return w.client.SchemaReferences(ctx, subject, version)
+}
+
 func (w *schemaRegistryClientWrapper) SetCompatibility(ctx context.Context, c sr.SetCompatibility, subjects ...string) []sr.CompatibilityResult {
 	return w.client.SetCompatibility(ctx, c, subjects...)
 }
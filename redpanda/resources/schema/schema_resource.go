@@ -3,14 +3,20 @@ package schema
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 )
 
 // ResourceSchemaSchema returns the schema for the schema resource.
@@ -28,6 +34,14 @@ func ResourceSchemaSchema(_ context.Context) schema.Schema {
 				Required:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
+			"context": schema.StringAttribute{
+				Description:   "The Schema Registry context the subject belongs to, without the leading dot (for example `tenant-a`). The subject is registered as `:.context:subject`, and dependent subjects resolved from proto_source_dir are registered in the same context. When omitted, the default context is used.",
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utils.SchemaRegistryContextPattern, "must be a context name without the leading dot or colons"),
+				},
+			},
 			"schema": schema.StringAttribute{
 				Description: "The schema definition in JSON format.",
 				Required:    true,
@@ -61,7 +75,7 @@ func ResourceSchemaSchema(_ context.Context) schema.Schema {
 							Required:    true,
 						},
 						"subject": schema.StringAttribute{
-							Description: "The subject of the referenced schema.",
+							Description: "The subject of the referenced schema. Bare subjects are resolved in the schema's own context; use `:.context:subject` to reference a subject in another context.",
 							Required:    true,
 						},
 						"version": schema.Int64Attribute{
@@ -71,6 +85,106 @@ func ResourceSchemaSchema(_ context.Context) schema.Schema {
 					},
 				},
 			},
+			"proto_source_dir": schema.StringAttribute{
+				Description: "Directory holding the .proto sources imported by a PROTOBUF schema. When set, each import is resolved relative to this directory and matched to an existing subject named after its import path, or registered under that subject (after its own imports), and the results are used as the schema references. A change to an imported file shows up in the plan and registers a new version of its subject and of this schema. Dependent subjects this resource registered are deleted with it. Conflicts with references.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("references")),
+				},
+			},
+			"resolved_references": schema.ListNestedAttribute{
+				Description: "The references registered with the schema: the configured references, or those resolved from proto_source_dir.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the referenced schema.",
+							Computed:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "The subject of the referenced schema.",
+							Computed:    true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "The version of the referenced schema.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"proto_dependencies": schema.ListNestedAttribute{
+				Description: "The files imported from proto_source_dir, directly or transitively, each listed after the files it imports.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The import path of the file.",
+							Computed:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "The subject the file is registered under.",
+							Computed:    true,
+						},
+						"version": schema.Int64Attribute{
+							Description: "The version of the subject matching the file.",
+							Computed:    true,
+						},
+						"sha256": schema.StringAttribute{
+							Description: "The SHA-256 digest of the file content, used to detect changes at plan time.",
+							Computed:    true,
+						},
+						"registered": schema.BoolAttribute{
+							Description: "Whether this resource created the subject. Subjects it created are deleted along with it.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"metadata": schema.SingleNestedAttribute{
+				Description: "Data contract metadata attached to the schema version: properties, tags and sensitive field names.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"properties": schema.MapAttribute{
+						Description: "Arbitrary key/value properties, such as the owner or the contract version.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"tags": schema.MapAttribute{
+						Description: "Tags keyed by schema path (for example `User.email`), such as `PII`.",
+						ElementType: types.ListType{ElemType: types.StringType},
+						Optional:    true,
+					},
+					"sensitive": schema.SetAttribute{
+						Description: "Names of properties whose values are sensitive.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"rule_set": schema.SingleNestedAttribute{
+				Description: "Data contract rules that govern the schema.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"domain_rules": schema.ListNestedAttribute{
+						Description:  "Rules applied when serializing (WRITE) or deserializing (READ) records, such as CEL validation.",
+						Optional:     true,
+						NestedObject: schema.NestedAttributeObject{Attributes: resourceRuleAttributes()},
+					},
+					"migration_rules": schema.ListNestedAttribute{
+						Description:  "Rules applied when records are read with a different schema version (UPGRADE, DOWNGRADE).",
+						Optional:     true,
+						NestedObject: schema.NestedAttributeObject{Attributes: resourceRuleAttributes()},
+					},
+				},
+			},
+			"auth_mode": schema.StringAttribute{
+				Description: "How the provider authenticates to Schema Registry for this resource: `provider_token` uses the provider's cloud token (no SASL user or password, which must then be omitted), `basic` uses HTTP Basic authentication with username and password (or password_wo). When omitted, Basic authentication is used if username and password are both set, otherwise the provider's cloud token.",
+				Optional:    true,
+				Validators: []validator.String{
+					validators.SchemaRegistryAuthMode(),
+				},
+			},
 			"username": schema.StringAttribute{
 				Description: "SASL username for Schema Registry HTTP Basic authentication. Optional: when omitted (together with password) the provider authenticates to Schema Registry using its cloud Bearer token. Supply username + password only when you need writes to be attributed to a specific SASL identity (e.g., audit / least-privilege).",
 				Optional:    true,
@@ -109,6 +223,18 @@ func ResourceSchemaSchema(_ context.Context) schema.Schema {
 					),
 				},
 			},
+			"delete_mode": schema.StringAttribute{
+				Description: "How schema versions are deleted on destroy and when pruning with keep_versions: `soft` (default) keeps them recoverable in the registry, `hard` permanently deletes them after the required soft delete.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("soft"),
+				Validators:  []validator.String{stringvalidator.OneOf("soft", "hard")},
+			},
+			"keep_versions": schema.Int64Attribute{
+				Description: "When set, after each successful update only the newest keep_versions versions of the subject are kept; older versions are deleted according to delete_mode. Versions referenced by other subjects are never pruned.",
+				Optional:    true,
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
 			"allow_deletion": schema.BoolAttribute{
 				Description: "Whether terraform may destroy this schema subject. Defaults to `false` — `terraform destroy` will refuse until you set this to `true`. After `terraform import`, defaults to `false` regardless of what was previously in state; set to `true` in your config before destroy.",
 				Optional:    true,
@@ -118,3 +244,61 @@ func ResourceSchemaSchema(_ context.Context) schema.Schema {
 		},
 	}
 }
+
+// resourceRuleAttributes returns the attributes of a single data contract rule.
+func resourceRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Description: "User-defined name of the rule.",
+			Required:    true,
+		},
+		"doc": schema.StringAttribute{
+			Description: "Description of the rule.",
+			Optional:    true,
+		},
+		"kind": schema.StringAttribute{
+			Description: "The kind of rule (TRANSFORM, CONDITION).",
+			Required:    true,
+			Validators:  []validator.String{stringvalidator.OneOf("TRANSFORM", "CONDITION")},
+		},
+		"mode": schema.StringAttribute{
+			Description: "When the rule applies (UPGRADE, DOWNGRADE, UPDOWN, WRITE, READ, WRITEREAD).",
+			Required:    true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("UPGRADE", "DOWNGRADE", "UPDOWN", "WRITE", "READ", "WRITEREAD"),
+			},
+		},
+		"type": schema.StringAttribute{
+			Description: "The rule executor, for example `CEL`, `CEL_FIELD` or `JSONATA`.",
+			Required:    true,
+		},
+		"tags": schema.ListAttribute{
+			Description: "Tags the rule applies to.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"params": schema.MapAttribute{
+			Description: "Parameters passed to the rule executor.",
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"expr": schema.StringAttribute{
+			Description: "The rule expression.",
+			Optional:    true,
+		},
+		"on_success": schema.StringAttribute{
+			Description: "Action to run when the rule succeeds, for example `NONE`.",
+			Optional:    true,
+		},
+		"on_failure": schema.StringAttribute{
+			Description: "Action to run when the rule fails, for example `ERROR` or `DLQ`.",
+			Optional:    true,
+		},
+		"disabled": schema.BoolAttribute{
+			Description: "Whether the rule is disabled. Defaults to `false`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+}
@@ -23,12 +23,18 @@ import (
 	"github.com/redpanda-data/terraform-provider-redpanda/internal/testutil"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/acl"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/cluster"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/effectivepermissions"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/group"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/network"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/organizationuser"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/pipeline"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/principalmapping"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/region"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/regions"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/resourcegroup"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/role"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/rolebinding"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/rolemembers"
 	rpschema "github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/schema"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/schemaregistryacl"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/resources/serverlesscluster"
@@ -59,10 +65,17 @@ func TestSchemaGolden(t *testing.T) {
 	}{
 		{"acl_resource", acl.ResourceACLSchema(ctx)},
 		{"cluster_resource", cluster.ResourceClusterSchema(ctx)},
+		{"group_resource", group.ResourceGroupSchema(ctx)},
+		{"groupmembership_resource", group.ResourceGroupMembershipSchema(ctx)},
 		{"network_resource", network.ResourceNetworkSchema(ctx)},
+		{"organizationuser_resource", organizationuser.ResourceOrganizationUserSchema(ctx)},
 		{"pipeline_resource", pipeline.ResourcePipelineSchema(ctx)},
+		{"principalmapping_resource", principalmapping.ResourcePrincipalMappingSchema(ctx)},
+		{"readreplicatopic_resource", topic.ResourceReadReplicaTopicSchema(ctx)},
 		{"resourcegroup_resource", resourcegroup.ResourceGroupSchema(ctx)},
 		{"role_resource", role.ResourceRoleSchema(ctx)},
+		{"rolemembers_resource", rolemembers.ResourceRoleMembersSchema(ctx)},
+		{"rolebinding_resource", rolebinding.ResourceRoleBindingSchema(ctx)},
 		{"schema_resource", rpschema.ResourceSchemaSchema(ctx)},
 		{"schemaregistryacl_resource", schemaregistryacl.ResourceSchemaRegistryACLSchema(ctx)},
 		{"serverlesscluster_resource", serverlesscluster.ResourceServerlessClusterSchema(ctx)},
@@ -70,16 +83,23 @@ func TestSchemaGolden(t *testing.T) {
 		{"serviceaccount_resource", serviceaccount.ResourceServiceAccountSchema(ctx)},
 		{"shadowlink_resource", shadowlink.ResourceShadowLinkSchema(ctx)},
 		{"topic_resource", topic.ResourceTopicSchema(ctx)},
+		{"topicset_resource", topic.ResourceTopicSetSchema(ctx)},
+		{"topicmigration_resource", topic.ResourceTopicMigrationSchema(ctx)},
 		{"user_resource", user.ResourceUserSchema(ctx)},
 
 		{"cluster_datasource", cluster.DatasourceClusterSchema(ctx)},
+		{"effectivepermissions_datasource", effectivepermissions.DataSourceEffectivePermissionsSchema(ctx)},
+		{"groups_datasource", group.DataSourceGroupsSchema(ctx)},
 		{"network_datasource", network.DatasourceNetworkSchema(ctx)},
+		{"organizationusers_datasource", organizationuser.DataSourceOrganizationUsersSchema(ctx)},
 		{"region_datasource", region.DataSourceRegionSchema(ctx)},
 		{"regions_datasource", regions.DataSourceRegionsSchema(ctx)},
 		{"resourcegroup_datasource", resourcegroup.DatasourceResourceGroupSchema(ctx)},
+		{"rolebinding_datasource", rolebinding.DatasourceRoleBindingSchema(ctx)},
 		{"serverlesscluster_datasource", serverlesscluster.DatasourceServerlessClusterSchema(ctx)},
 		{"serverlessregions_datasource", serverlessregions.DataSourceServerlessRegionsSchema(ctx)},
 		{"throughputtiers_datasource", throughputtiers.DataSourceThroughputTiersSchema(ctx)},
+		{"serviceaccountcredentials_ephemeral", serviceaccount.EphemeralServiceAccountCredentialsSchema(ctx)},
 	}
 
 	for _, tt := range tests {
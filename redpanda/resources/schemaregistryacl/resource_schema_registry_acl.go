@@ -202,16 +202,17 @@ func (s *SchemaRegistryACL) Delete(ctx context.Context, request resource.DeleteR
 }
 
 type importIDComponents struct {
-	clusterID    string
-	principal    string
-	resourceType string
-	resourceName string
-	patternType  string
-	host         string
-	operation    string
-	permission   string
-	username     string
-	password     string
+	clusterID     string
+	principal     string
+	resourceType  string
+	schemaContext string
+	resourceName  string
+	patternType   string
+	host          string
+	operation     string
+	permission    string
+	username      string
+	password      string
 }
 
 func parseImportID(importID string) (*importIDComponents, error) {
@@ -235,6 +236,9 @@ func parseImportID(importID string) (*importIDComponents, error) {
 		operation:    parts[6],
 		permission:   parts[7],
 	}
+	if ret.resourceType == "SUBJECT" {
+		ret.schemaContext, ret.resourceName = utils.SplitQualifiedSubject(ret.resourceName)
+	}
 	if len(parts) == 10 {
 		ret.username = parts[8]
 		ret.password = parts[9]
@@ -247,6 +251,8 @@ func parseImportID(importID string) (*importIDComponents, error) {
 // Bearer auth (default): cluster_id,principal,resource_type,resource_name,pattern_type,host,operation,permission
 // Basic auth (optional): same 8 fields + ,username,password
 //
+// SUBJECT ACLs outside the default context use a :.context:name resource_name.
+//
 // For Basic auth, password can also be set via REDPANDA_IMPORT_PASSWORD env var.
 func (*SchemaRegistryACL) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	components, err := parseImportID(request.ID)
@@ -264,6 +270,9 @@ func (*SchemaRegistryACL) ImportState(ctx context.Context, request resource.Impo
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("principal"), components.principal)...)
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("resource_type"), components.resourceType)...)
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("resource_name"), components.resourceName)...)
+	if components.schemaContext != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("context"), components.schemaContext)...)
+	}
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("pattern_type"), components.patternType)...)
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("host"), components.host)...)
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("operation"), components.operation)...)
@@ -281,7 +290,7 @@ func (*SchemaRegistryACL) ImportState(ctx context.Context, request resource.Impo
 		components.clusterID,
 		components.principal,
 		components.resourceType,
-		components.resourceName,
+		utils.QualifySubject(components.schemaContext, components.resourceName),
 		components.patternType,
 		components.host,
 		components.operation,
@@ -292,7 +301,8 @@ func (*SchemaRegistryACL) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (s *SchemaRegistryACL) getSchemaRegistryClient(ctx context.Context, model *schemaregistryaclmodel.ResourceModel) (kclients.SchemaRegistryACLClientInterface, error) {
-	return s.clientFactory(ctx, s.CpCl, model.ClusterID.ValueString(), s.resData.TokenSource, model.Username.ValueString(), model.GetEffectivePassword())
+	username, password := model.GetCredentials()
+	return s.clientFactory(ctx, s.CpCl, model.ClusterID.ValueString(), s.resData.TokenSource, username, password)
 }
 
 // verifyACLPropagation verifies that the ACL has been propagated and is ready for use.
@@ -1153,6 +1153,21 @@ func TestUnit_SchemaRegistryACL_ParseImportID(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "valid Bearer - context-qualified subject",
+			importID: "cluster-1,User:alice,SUBJECT,:.tenant-a:orders-value,LITERAL,*,READ,ALLOW",
+			want: &importIDComponents{
+				clusterID:     "cluster-1",
+				principal:     "User:alice",
+				resourceType:  "SUBJECT",
+				schemaContext: "tenant-a",
+				resourceName:  "orders-value",
+				patternType:   "LITERAL",
+				host:          "*",
+				operation:     "READ",
+				permission:    "ALLOW",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1174,6 +1189,7 @@ func TestUnit_SchemaRegistryACL_ParseImportID(t *testing.T) {
 			assert.Equal(t, tt.want.clusterID, got.clusterID, "clusterID should match")
 			assert.Equal(t, tt.want.principal, got.principal, "principal should match")
 			assert.Equal(t, tt.want.resourceType, got.resourceType, "resourceType should match")
+			assert.Equal(t, tt.want.schemaContext, got.schemaContext, "schemaContext should match")
 			assert.Equal(t, tt.want.resourceName, got.resourceName, "resourceName should match")
 			assert.Equal(t, tt.want.patternType, got.patternType, "patternType should match")
 			assert.Equal(t, tt.want.host, got.host, "host should match")
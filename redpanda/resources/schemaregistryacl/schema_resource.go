@@ -3,12 +3,14 @@ package schemaregistryacl
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 )
 
@@ -49,6 +51,17 @@ func ResourceSchemaRegistryACLSchema(_ context.Context) schema.Schema {
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"context": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Schema Registry context of the subjects this ACL applies to, without the leading dot (for example `tenant-a`). Only valid for SUBJECT ACLs; resource_name is matched within this context. When omitted, the ACL applies to the default context",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(utils.SchemaRegistryContextPattern, "must be a context name without the leading dot or colons"),
+					validators.SchemaRegistryContext(),
+				},
+			},
 			"pattern_type": schema.StringAttribute{
 				Required:    true,
 				Description: "The pattern type of the resource: LITERAL or PREFIXED",
@@ -90,6 +103,13 @@ func ResourceSchemaRegistryACLSchema(_ context.Context) schema.Schema {
 				Computed:      true,
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
+			"auth_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "How the provider authenticates to Schema Registry for this resource: `provider_token` uses the provider's cloud token (no SASL user or password, which must then be omitted), `basic` uses HTTP Basic authentication with username and password (or password_wo). When omitted, Basic authentication is used if username and password are both set, otherwise the provider's cloud token.",
+				Validators: []validator.String{
+					validators.SchemaRegistryAuthMode(),
+				},
+			},
 			"username": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
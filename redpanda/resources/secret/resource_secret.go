@@ -323,7 +323,7 @@ func (s *Secret) createSecretClient(ctx context.Context, clusterURL string) erro
 	if s.SecretClient != nil {
 		return nil
 	}
-	client, err := utils.NewDataplaneClient(ctx, s.resData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewSecretServiceClient)
+	client, err := utils.NewDataplaneClient(ctx, s.resData.DataplaneConnPool, clusterURL, utils.CapabilityAware(clusterURL, s.CpCl, dataplanev1grpc.NewSecretServiceClient))
 	if err != nil {
 		return err
 	}
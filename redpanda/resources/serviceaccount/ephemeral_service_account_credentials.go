@@ -0,0 +1,92 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package serviceaccount
+
+import (
+	"context"
+
+	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	serviceaccountmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/serviceaccount"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralServiceAccountCredentials{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralServiceAccountCredentials{}
+)
+
+// EphemeralServiceAccountCredentials fetches the current client credentials of
+// a service account without persisting them to Terraform state or plan.
+type EphemeralServiceAccountCredentials struct {
+	base.EphemeralResourceBase
+}
+
+// NewEphemeralServiceAccountCredentials constructs an
+// EphemeralServiceAccountCredentials ephemeral resource.
+func NewEphemeralServiceAccountCredentials() *EphemeralServiceAccountCredentials {
+	e := &EphemeralServiceAccountCredentials{}
+	e.EphemeralResourceBase = base.NewEphemeralResourceBase("redpanda_service_account_credentials", EphemeralServiceAccountCredentialsSchema)
+	return e
+}
+
+// EphemeralServiceAccountCredentialsSchema returns the schema for the
+// EphemeralServiceAccountCredentials ephemeral resource.
+func EphemeralServiceAccountCredentialsSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Retrieves the current client credentials of a Redpanda Cloud service account. The values are never stored in state or plan",
+		Attributes: map[string]schema.Attribute{
+			"service_account_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the service account",
+			},
+			"client_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The client ID of the service account",
+			},
+			"client_secret": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current client secret of the service account",
+			},
+		},
+	}
+}
+
+// Open fetches the service account credentials.
+func (e *EphemeralServiceAccountCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var cfg serviceaccountmodel.CredentialsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := cfg.ServiceAccountID.ValueString()
+	apiResp, err := e.CpCl.ServiceAccount.GetServiceAccountCredentials(ctx, &iamv1.GetServiceAccountCredentialsRequest{Id: id})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read service account credentials", utils.DeserializeGrpcError(err))
+		return
+	}
+	creds := apiResp.GetAuth0ClientCredentials()
+	if creds == nil {
+		resp.Diagnostics.AddError("failed to read service account credentials", "API response did not contain credentials; please report this bug to Redpanda Support")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, serviceaccountmodel.FlattenCredentials(id, creds))...)
+}
@@ -17,8 +17,10 @@ package serviceaccount
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"buf.build/gen/go/redpandadata/cloud/grpc/go/redpanda/api/iam/v1/iamv1grpc"
 	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,6 +34,7 @@ var (
 	_ resource.Resource                = &ServiceAccount{}
 	_ resource.ResourceWithConfigure   = &ServiceAccount{}
 	_ resource.ResourceWithImportState = &ServiceAccount{}
+	_ resource.ResourceWithModifyPlan  = &ServiceAccount{}
 )
 
 // ServiceAccount represents the Redpanda Cloud service-account resource.
@@ -111,7 +114,28 @@ func (s *ServiceAccount) Read(ctx context.Context, req resource.ReadRequest, res
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
-// Update applies name/description changes via FieldMask.
+// ModifyPlan marks client_secret unknown when client_secret_version changes,
+// since Update will rotate it and the new value is only known after apply.
+func (*ServiceAccount) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var planVersion, stateVersion types.Int64
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("client_secret_version"), &planVersion)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("client_secret_version"), &stateVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// An unknown version (e.g. from time_rotating) may turn out to differ at
+	// apply time, so the secret has to be unknown as well.
+	if !planVersion.IsUnknown() && !secretRotationRequested(planVersion, stateVersion) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("auth0_client_credentials").AtName("client_secret"), types.StringUnknown())...)
+}
+
+// Update applies name/description changes via FieldMask and rotates the client
+// secret when client_secret_version changes.
 func (s *ServiceAccount) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan serviceaccountmodel.ResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -162,6 +186,23 @@ func (s *ServiceAccount) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if secretRotationRequested(plan.ClientSecretVersion, state.ClientSecretVersion) {
+		creds, err := rotateClientSecret(ctx, s.CpCl.ServiceAccount, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to rotate service account client secret", utils.DeserializeGrpcError(err))
+			return
+		}
+		obj, oDiags := serviceaccountmodel.Auth0ClientCredentialsToObject(ctx, &serviceaccountmodel.Auth0ClientCredentialsModel{
+			ClientID:     types.StringValue(creds.GetClientId()),
+			ClientSecret: types.StringValue(creds.GetClientSecret()),
+		})
+		resp.Diagnostics.Append(oDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		newState.Auth0ClientCredentials = obj
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
@@ -219,3 +260,27 @@ func (*ServiceAccount) ImportState(ctx context.Context, req resource.ImportState
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("auth0_client_credentials"), obj)...)
 }
+
+// secretRotationRequested reports whether the client secret must be rotated:
+// client_secret_version is set and differs from the prior state. Removing the
+// attribute from configuration does not rotate.
+func secretRotationRequested(plan, state types.Int64) bool {
+	if plan.IsNull() || plan.IsUnknown() {
+		return false
+	}
+	return !plan.Equal(state)
+}
+
+// rotateClientSecret rotates the client secret of the service account and
+// returns the newly issued credentials.
+func rotateClientSecret(ctx context.Context, cl iamv1grpc.ServiceAccountServiceClient, id string) (*iamv1.ServiceAccountCredentials, error) {
+	resp, err := cl.RotateServiceAccountSecret(ctx, &iamv1.RotateServiceAccountSecretRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	creds := resp.GetAuth0ClientCredentials()
+	if creds.GetClientSecret() == "" {
+		return nil, fmt.Errorf("rotate response for service account %q did not contain a client secret", id)
+	}
+	return creds, nil
+}
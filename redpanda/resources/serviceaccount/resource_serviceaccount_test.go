@@ -16,12 +16,17 @@
 package serviceaccount
 
 import (
+	"context"
 	"testing"
 
 	iamv1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/iam/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
 )
 
 // Update payload must carry the original `name` when only `description`
@@ -48,3 +53,57 @@ func TestUnit_ServiceAccount_Update_PreservesNameOnDescriptionOnlyChange(t *test
 	assert.Equal(t, []string{"description"}, mask.Paths,
 		"mask must only flag the changed field")
 }
+
+func TestUnit_ServiceAccount_SecretRotationRequested(t *testing.T) {
+	tests := []struct {
+		name  string
+		plan  types.Int64
+		state types.Int64
+		want  bool
+	}{
+		{name: "unset", plan: types.Int64Null(), state: types.Int64Null(), want: false},
+		{name: "unchanged", plan: types.Int64Value(1), state: types.Int64Value(1), want: false},
+		{name: "first set", plan: types.Int64Value(1), state: types.Int64Null(), want: true},
+		{name: "incremented", plan: types.Int64Value(2), state: types.Int64Value(1), want: true},
+		{name: "removed", plan: types.Int64Null(), state: types.Int64Value(1), want: false},
+		{name: "unknown", plan: types.Int64Unknown(), state: types.Int64Value(1), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, secretRotationRequested(tt.plan, tt.state))
+		})
+	}
+}
+
+func TestUnit_ServiceAccount_RotateClientSecret(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("returns rotated credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cl := mocks.NewMockServiceAccountServiceClient(ctrl)
+		cl.EXPECT().
+			RotateServiceAccountSecret(gomock.Any(), &iamv1.RotateServiceAccountSecretRequest{Id: "sa-1"}).
+			Return(&iamv1.RotateServiceAccountSecretResponse{
+				Auth0ClientCredentials: &iamv1.ServiceAccountCredentials{
+					ClientId:     "client-1",
+					ClientSecret: proto.String("new-secret"),
+				},
+			}, nil)
+
+		creds, err := rotateClientSecret(ctx, cl, "sa-1")
+		require.NoError(t, err)
+		assert.Equal(t, "client-1", creds.GetClientId())
+		assert.Equal(t, "new-secret", creds.GetClientSecret())
+	})
+
+	t.Run("errors on empty secret", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		cl := mocks.NewMockServiceAccountServiceClient(ctrl)
+		cl.EXPECT().
+			RotateServiceAccountSecret(gomock.Any(), gomock.Any()).
+			Return(&iamv1.RotateServiceAccountSecretResponse{}, nil)
+
+		_, err := rotateClientSecret(ctx, cl, "sa-1")
+		require.Error(t, err)
+	})
+}
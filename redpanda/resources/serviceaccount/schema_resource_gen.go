@@ -74,6 +74,11 @@ func ResourceServiceAccountSchema(ctx context.Context) schema.Schema {
 				},
 			},
 
+			"client_secret_version": schema.Int64Attribute{
+				Description: "Version number for the client secret. Change this value to rotate auth0_client_credentials.client_secret without replacing the service account.",
+				Optional:    true,
+			},
+
 			"auth0_client_credentials": schema.SingleNestedAttribute{
 				Description:   "Auth0 Client Credentials configuration",
 				Computed:      true,
@@ -0,0 +1,173 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strconv"
+
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/topicconfig"
+)
+
+// configBlock is a nested attribute whose attributes each set one topic
+// property, such as iceberg or tiered_storage. While the block is set, its
+// properties belong to it and must not also appear in configuration.
+type configBlock struct {
+	// attr is the name of the root attribute.
+	attr string
+	// properties maps each nested attribute to the topic property it sets.
+	properties map[string]string
+	// field returns the block on the model.
+	field func(m *topicmodel.ResourceModel) *types.Object
+}
+
+// configBlocks lists every block of redpanda_topic that sets topic
+// properties.
+var configBlocks = []configBlock{icebergBlock, tieredStorageBlock}
+
+// owns reports whether name is one of the block's topic properties.
+func (b configBlock) owns(name string) bool {
+	for _, p := range b.properties {
+		if p == name {
+			return true
+		}
+	}
+	return false
+}
+
+// values returns the topic properties set through obj. Null attributes are
+// left out so the cluster defaults apply; booleans are written as
+// "true"/"false".
+func (b configBlock) values(obj types.Object) map[string]string {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	out := map[string]string{}
+	for name, v := range obj.Attributes() {
+		prop, ok := b.properties[name]
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		switch v := v.(type) {
+		case types.String:
+			out[prop] = v.ValueString()
+		case types.Bool:
+			out[prop] = strconv.FormatBool(v.ValueBool())
+		}
+	}
+	return out
+}
+
+// conflicts returns the configuration keys that the block also sets.
+func (b configBlock) conflicts(cfg types.Map) []string {
+	var keys []string
+	for k := range cfg.Elements() {
+		if b.owns(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// flatten refreshes the prior block from the properties the broker reports.
+// Attributes left unset stay null so cluster defaults do not show up as
+// drift, and a null prior stays null. Strings keep the planned spelling when
+// the broker reports the same value in normalised units.
+func (b configBlock) flatten(ctx context.Context, all []*dataplanev1.Topic_Configuration, prior types.Object) (types.Object, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior, nil
+	}
+	reported := make(map[string]string, len(all))
+	for _, c := range all {
+		if c != nil && c.Value != nil {
+			reported[c.GetName()] = c.GetValue()
+		}
+	}
+	attrs := make(map[string]attr.Value, len(prior.Attributes()))
+	for name, v := range prior.Attributes() {
+		attrs[name] = v
+		got, ok := reported[b.properties[name]]
+		if !ok || v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		switch v := v.(type) {
+		case types.String:
+			if !topicconfig.Equivalent(b.properties[name], v.ValueString(), got) {
+				attrs[name] = types.StringValue(got)
+			}
+		case types.Bool:
+			if parsed, err := strconv.ParseBool(got); err == nil {
+				attrs[name] = types.BoolValue(parsed)
+			}
+		}
+	}
+	return types.ObjectValue(prior.AttributeTypes(ctx), attrs)
+}
+
+// withConfigBlocks returns the model's configuration with the properties of
+// every set block added, ready to be sent to the broker.
+func withConfigBlocks(m *topicmodel.ResourceModel) (types.Map, diag.Diagnostics) {
+	values := map[string]string{}
+	for _, b := range configBlocks {
+		for k, v := range b.values(*b.field(m)) {
+			values[k] = v
+		}
+	}
+	if len(values) == 0 {
+		return m.Configuration, nil
+	}
+	elems := make(map[string]attr.Value, len(m.Configuration.Elements())+len(values))
+	for k, v := range m.Configuration.Elements() {
+		elems[k] = v
+	}
+	for k, v := range values {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValue(types.StringType, elems)
+}
+
+// withoutConfigBlocks drops the properties owned by the blocks set on the
+// model, so they are not also recorded under configuration.
+func withoutConfigBlocks(m *topicmodel.ResourceModel, cfgs []*dataplanev1.Topic_Configuration) []*dataplanev1.Topic_Configuration {
+	var owners []configBlock
+	for _, b := range configBlocks {
+		if !b.field(m).IsNull() {
+			owners = append(owners, b)
+		}
+	}
+	out := make([]*dataplanev1.Topic_Configuration, 0, len(cfgs))
+	for _, c := range cfgs {
+		if !slices.ContainsFunc(owners, func(b configBlock) bool { return b.owns(c.GetName()) }) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// configBlocksChanged reports whether any block differs between plan and
+// state.
+func configBlocksChanged(plan, state *topicmodel.ResourceModel) bool {
+	return slices.ContainsFunc(configBlocks, func(b configBlock) bool {
+		return !b.field(plan).Equal(*b.field(state))
+	})
+}
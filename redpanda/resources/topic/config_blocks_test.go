@@ -0,0 +1,181 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tieredStorageObject(t *testing.T, m *topicmodel.TieredStorageModel) types.Object {
+	t.Helper()
+	obj, diags := topicmodel.TieredStorageToObject(context.Background(), m)
+	require.False(t, diags.HasError(), diags)
+	return obj
+}
+
+func blocksModel(cfg types.Map, iceberg, tiered types.Object) *topicmodel.ResourceModel {
+	return &topicmodel.ResourceModel{
+		Configuration:     cfg,
+		ConfigurationMode: types.StringValue("additive"),
+		Iceberg:           iceberg,
+		TieredStorage:     tiered,
+	}
+}
+
+func TestUnit_Topic_WithConfigBlocks(t *testing.T) {
+	cfg := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"retention.ms": types.StringValue("7d"),
+	})
+	nullIceberg := types.ObjectNull(topicmodel.IcebergAttrTypes())
+	nullTiered := types.ObjectNull(topicmodel.TieredStorageAttrTypes())
+
+	got, diags := withConfigBlocks(blocksModel(cfg, nullIceberg, nullTiered))
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, got.Equal(cfg), "null blocks leave configuration unchanged")
+
+	got, diags = withConfigBlocks(blocksModel(cfg, icebergObject(t, &topicmodel.IcebergModel{
+		Mode:                types.StringValue("key_value"),
+		PartitionSpec:       types.StringValue("(hour(redpanda.timestamp))"),
+		InvalidRecordAction: types.StringNull(),
+	}), tieredStorageObject(t, &topicmodel.TieredStorageModel{
+		RemoteRead:          types.BoolValue(true),
+		RemoteWrite:         types.BoolValue(false),
+		LocalRetentionBytes: types.StringNull(),
+		LocalRetentionMs:    types.StringValue("1d"),
+	})))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]attr.Value{
+		"retention.ms":                    types.StringValue("7d"),
+		"redpanda.iceberg.mode":           types.StringValue("key_value"),
+		"redpanda.iceberg.partition.spec": types.StringValue("(hour(redpanda.timestamp))"),
+		"redpanda.remote.read":            types.StringValue("true"),
+		"redpanda.remote.write":           types.StringValue("false"),
+		"retention.local.target.ms":       types.StringValue("1d"),
+	}, got.Elements(), "unset attributes are left to the cluster default")
+
+	got, diags = withConfigBlocks(blocksModel(types.MapUnknown(types.StringType), icebergObject(t, &topicmodel.IcebergModel{
+		Mode:                types.StringValue("disabled"),
+		PartitionSpec:       types.StringNull(),
+		InvalidRecordAction: types.StringNull(),
+	}), nullTiered))
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, map[string]attr.Value{"redpanda.iceberg.mode": types.StringValue("disabled")}, got.Elements())
+}
+
+func TestUnit_Topic_ConfigBlockFlatten(t *testing.T) {
+	ctx := context.Background()
+	all := []*dataplanev1.Topic_Configuration{
+		{Name: "redpanda.iceberg.mode", Value: strPtr("value_schema_latest"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "redpanda.iceberg.partition.spec", Value: strPtr("(day(redpanda.timestamp))"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "redpanda.iceberg.invalid.record.action", Value: strPtr("dlq_table"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+		{Name: "redpanda.remote.read", Value: strPtr("false"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "redpanda.remote.write", Value: strPtr("true"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+		{Name: "retention.local.target.ms", Value: strPtr("86400000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "retention.local.target.bytes", Value: strPtr("2048"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+	}
+
+	null := types.ObjectNull(topicmodel.IcebergAttrTypes())
+	got, diags := icebergBlock.flatten(ctx, all, null)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, got.IsNull(), "a topic without the block keeps it null")
+
+	got, diags = icebergBlock.flatten(ctx, all, icebergObject(t, &topicmodel.IcebergModel{
+		Mode:                types.StringValue("key_value"),
+		PartitionSpec:       types.StringValue("(hour(redpanda.timestamp))"),
+		InvalidRecordAction: types.StringNull(),
+	}))
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, got.Equal(icebergObject(t, &topicmodel.IcebergModel{
+		Mode:                types.StringValue("value_schema_latest"),
+		PartitionSpec:       types.StringValue("(day(redpanda.timestamp))"),
+		InvalidRecordAction: types.StringNull(),
+	})), "set attributes report drift and unset attributes ignore the cluster default: %s", got)
+
+	got, diags = tieredStorageBlock.flatten(ctx, all, tieredStorageObject(t, &topicmodel.TieredStorageModel{
+		RemoteRead:          types.BoolValue(true),
+		RemoteWrite:         types.BoolNull(),
+		LocalRetentionBytes: types.StringValue("1KiB"),
+		LocalRetentionMs:    types.StringValue("1d"),
+	}))
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, got.Equal(tieredStorageObject(t, &topicmodel.TieredStorageModel{
+		RemoteRead:          types.BoolValue(false),
+		RemoteWrite:         types.BoolNull(),
+		LocalRetentionBytes: types.StringValue("2048"),
+		LocalRetentionMs:    types.StringValue("1d"),
+	})), "booleans are parsed, equivalent sizes keep the planned spelling and changed ones drift: %s", got)
+}
+
+func TestUnit_Topic_RefreshConfiguration_BlockKeysLeaveConfiguration(t *testing.T) {
+	ctx := context.Background()
+	all := []*dataplanev1.Topic_Configuration{
+		{Name: "retention.ms", Value: strPtr("1000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "redpanda.iceberg.mode", Value: strPtr("key_value"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "retention.local.target.ms", Value: strPtr("3600000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+	}
+	cfg := func() types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{
+			"retention.ms": types.StringValue("1000"),
+		})
+	}
+	m := blocksModel(cfg(), icebergObject(t, &topicmodel.IcebergModel{
+		Mode:                types.StringValue("key_value"),
+		PartitionSpec:       types.StringNull(),
+		InvalidRecordAction: types.StringNull(),
+	}), tieredStorageObject(t, &topicmodel.TieredStorageModel{
+		RemoteRead:          types.BoolNull(),
+		RemoteWrite:         types.BoolNull(),
+		LocalRetentionBytes: types.StringNull(),
+		LocalRetentionMs:    types.StringValue("1h"),
+	}))
+	m.ConfigurationMode = types.StringValue("authoritative")
+
+	require.False(t, refreshConfiguration(ctx, m, all).HasError())
+	assert.Equal(t, map[string]attr.Value{"retention.ms": types.StringValue("1000")}, m.Configuration.Elements(),
+		"properties owned by a block must not be reported under configuration")
+	ts, diags := m.AsTieredStorage(ctx)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "1h", ts.LocalRetentionMs.ValueString())
+
+	m.Iceberg = types.ObjectNull(topicmodel.IcebergAttrTypes())
+	m.TieredStorage = types.ObjectNull(topicmodel.TieredStorageAttrTypes())
+	m.Configuration = cfg()
+	require.False(t, refreshConfiguration(ctx, m, all).HasError())
+	assert.Contains(t, m.Configuration.Elements(), "redpanda.iceberg.mode",
+		"without the block, authoritative mode reports the override under configuration")
+	assert.Contains(t, m.Configuration.Elements(), "retention.local.target.ms")
+}
+
+func TestUnit_Topic_ConfigBlockConflicts(t *testing.T) {
+	cfg := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"redpanda.iceberg.partition.spec": types.StringValue("()"),
+		"redpanda.iceberg.mode":           types.StringValue("key_value"),
+		"redpanda.iceberg.delete":         types.StringValue("true"),
+		"redpanda.remote.write":           types.StringValue("true"),
+		"retention.local.target.bytes":    types.StringValue("1GiB"),
+		"retention.ms":                    types.StringValue("1000"),
+	})
+	assert.Equal(t, []string{"redpanda.iceberg.mode", "redpanda.iceberg.partition.spec"}, icebergBlock.conflicts(cfg))
+	assert.Equal(t, []string{"redpanda.remote.write", "retention.local.target.bytes"}, tieredStorageBlock.conflicts(cfg))
+	assert.Empty(t, icebergBlock.conflicts(types.MapNull(types.StringType)))
+}
+
+func TestUnit_Topic_ConfigBlocksChanged(t *testing.T) {
+	nullIceberg := types.ObjectNull(topicmodel.IcebergAttrTypes())
+	nullTiered := types.ObjectNull(topicmodel.TieredStorageAttrTypes())
+	cfg := types.MapNull(types.StringType)
+	tiered := tieredStorageObject(t, &topicmodel.TieredStorageModel{
+		RemoteRead:          types.BoolValue(true),
+		RemoteWrite:         types.BoolValue(true),
+		LocalRetentionBytes: types.StringNull(),
+		LocalRetentionMs:    types.StringNull(),
+	})
+	assert.False(t, configBlocksChanged(blocksModel(cfg, nullIceberg, tiered), blocksModel(cfg, nullIceberg, tiered)))
+	assert.True(t, configBlocksChanged(blocksModel(cfg, nullIceberg, tiered), blocksModel(cfg, nullIceberg, nullTiered)))
+}
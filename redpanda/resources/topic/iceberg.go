@@ -0,0 +1,98 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Topic properties written from the iceberg attribute.
+const (
+	icebergModeConfig                = "redpanda.iceberg.mode"
+	icebergPartitionSpecConfig       = "redpanda.iceberg.partition.spec"
+	icebergInvalidRecordActionConfig = "redpanda.iceberg.invalid.record.action"
+)
+
+// icebergBlock is the iceberg attribute, which sets the redpanda.iceberg.*
+// topic properties.
+var icebergBlock = configBlock{
+	attr: "iceberg",
+	properties: map[string]string{
+		"mode":                  icebergModeConfig,
+		"partition_spec":        icebergPartitionSpecConfig,
+		"invalid_record_action": icebergInvalidRecordActionConfig,
+	},
+	field: func(m *topicmodel.ResourceModel) *types.Object { return &m.Iceberg },
+}
+
+// icebergEnabledProperty is the cluster property that must be on before
+// topics can enable Iceberg.
+const icebergEnabledProperty = "iceberg_enabled"
+
+// icebergModeDisabled is the redpanda.iceberg.mode that turns Iceberg off.
+const icebergModeDisabled = "disabled"
+
+// clusterResolver looks up the dedicated or BYOC cluster behind a dataplane
+// API URL; *cloud.ControlPlaneClientSet implements it.
+type clusterResolver interface {
+	ClusterForAPIURL(ctx context.Context, apiURL string) (*controlplanev1.Cluster, error)
+}
+
+// icebergEnabled reports whether the iceberg attribute turns Iceberg on.
+func icebergEnabled(iceberg types.Object) bool {
+	mode, ok := icebergBlock.values(iceberg)[icebergModeConfig]
+	return ok && mode != icebergModeDisabled
+}
+
+// icebergClusterDiags checks that the cluster behind apiURL has Iceberg
+// enabled in its cluster configuration. Clusters that cannot be resolved,
+// such as serverless clusters or clusters outside the provider's
+// organization, are not checked.
+func icebergClusterDiags(ctx context.Context, resolver clusterResolver, apiURL string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if resolver == nil {
+		return diags
+	}
+	cl, err := resolver.ClusterForAPIURL(ctx, apiURL)
+	if err != nil || cl == nil {
+		return diags
+	}
+	fix := fmt.Sprintf("Set `%s` to true in the cluster_configuration of redpanda_cluster %q (%s), e.g. cluster_configuration = { custom_properties_json = jsonencode({ %s = true }) }.",
+		icebergEnabledProperty, cl.GetName(), cl.GetId(), icebergEnabledProperty)
+	v, ok := cl.GetClusterConfiguration().GetCustomProperties().GetFields()[icebergEnabledProperty]
+	switch {
+	case !ok:
+		diags.AddAttributeWarning(path.Root("iceberg").AtName("mode"), "Iceberg may not be enabled on the cluster",
+			fmt.Sprintf("The cluster configuration does not set %s, which defaults to false. Unless it was enabled outside Terraform, Redpanda will not write this topic to Iceberg. %s", icebergEnabledProperty, fix))
+	case !structTrue(v):
+		diags.AddAttributeError(path.Root("iceberg").AtName("mode"), "Iceberg is not enabled on the cluster",
+			fmt.Sprintf("The cluster configuration sets %s to %v. %s", icebergEnabledProperty, v.AsInterface(), fix))
+	}
+	return diags
+}
+
+// structTrue reports whether a cluster property value is true, accepting
+// both the JSON boolean and the string form.
+func structTrue(v *structpb.Value) bool {
+	return v.GetBoolValue() || v.GetStringValue() == "true"
+}
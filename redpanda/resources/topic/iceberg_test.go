@@ -0,0 +1,70 @@
+package topic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func icebergObject(t *testing.T, m *topicmodel.IcebergModel) types.Object {
+	t.Helper()
+	obj, diags := topicmodel.IcebergToObject(context.Background(), m)
+	require.False(t, diags.HasError(), diags)
+	return obj
+}
+
+type fakeClusterResolver struct {
+	cluster *controlplanev1.Cluster
+	err     error
+}
+
+func (r fakeClusterResolver) ClusterForAPIURL(context.Context, string) (*controlplanev1.Cluster, error) {
+	return r.cluster, r.err
+}
+
+func TestUnit_Topic_IcebergClusterDiags(t *testing.T) {
+	withProps := func(props map[string]any) *controlplanev1.Cluster {
+		s, err := structpb.NewStruct(props)
+		require.NoError(t, err)
+		return &controlplanev1.Cluster{
+			Id:                   "cq1k5aq2e1l3jv3vkc5g",
+			Name:                 "analytics",
+			ClusterConfiguration: &controlplanev1.Cluster_ClusterConfiguration{CustomProperties: s},
+		}
+	}
+	tests := []struct {
+		name        string
+		resolver    clusterResolver
+		wantError   bool
+		wantWarning bool
+	}{
+		{name: "no resolver", resolver: nil},
+		{name: "cluster not found", resolver: fakeClusterResolver{}},
+		{name: "lookup error", resolver: fakeClusterResolver{err: errors.New("denied")}},
+		{name: "enabled", resolver: fakeClusterResolver{cluster: withProps(map[string]any{"iceberg_enabled": true})}},
+		{name: "enabled as string", resolver: fakeClusterResolver{cluster: withProps(map[string]any{"iceberg_enabled": "true"})}},
+		{name: "disabled", resolver: fakeClusterResolver{cluster: withProps(map[string]any{"iceberg_enabled": false})}, wantError: true},
+		{name: "not set", resolver: fakeClusterResolver{cluster: withProps(map[string]any{})}, wantWarning: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := icebergClusterDiags(context.Background(), tt.resolver, "https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.byoc.prd.cloud.redpanda.com")
+			assert.Equal(t, tt.wantError, diags.HasError(), diags)
+			assert.Equal(t, tt.wantWarning, len(diags.Warnings()) > 0, diags)
+			for _, d := range diags {
+				if d.Severity() == diag.SeverityError {
+					assert.Contains(t, d.Detail(), "analytics")
+				}
+				assert.Contains(t, d.Detail(), "custom_properties_json = jsonencode(", "the fix-it must name a redpanda_cluster attribute that exists")
+			}
+		})
+	}
+}
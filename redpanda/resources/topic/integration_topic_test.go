@@ -371,6 +371,88 @@ func TestIntegration_Topic_MergeWithPlannedConfig_PreservesUserNamedRedpandaKey(
 	})
 }
 
+// TestIntegration_Topic_AuthoritativeConfiguration_Create creates a topic in
+// authoritative mode on a broker that pins redpanda.remote.write on new
+// topics. Create must remove the undeclared override so the state recorded
+// after apply equals the plan; Terraform core fails the CreateStep with
+// "Provider produced inconsistent result after apply" otherwise, and the
+// NoopReapplyStep proves the next refresh finds no drift.
+func TestIntegration_Topic_AuthoritativeConfiguration_Create(t *testing.T) {
+	srv, factories := integration.Setup(t)
+	srv.Topic.SetServerInjectedConfig("redpanda.storage.mode", "unset")
+	srv.Topic.SetCreateInjectedConfig("redpanda.remote.write", "true")
+
+	const name = "tfrp-mock-topic-auth-create"
+	cfg := mockTopicCreateConfig(name, "bufnet", 3, 1,
+		"  configuration_mode = \"authoritative\"\n  configuration = {\n    \"retention.ms\" = \"86400000\"\n  }\n", true)
+
+	checks := func() []statecheck.StateCheck {
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(topicAddr, tfjsonpath.New("configuration"), knownvalue.MapExact(map[string]knownvalue.Check{
+				"retention.ms": knownvalue.StringExact("86400000"),
+			})),
+		}
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			integration.CreateStep(topicAddr, cfg, checks()),
+			integration.NoopReapplyStep(topicAddr, cfg, checks()),
+		},
+	})
+}
+
+// TestIntegration_Topic_AuthoritativeConfiguration_ModeSwitch switches an
+// additive topic to authoritative. Additive state leaves out the
+// redpanda.remote.write override the broker pinned at create and the
+// redpanda.remote.read override set out of band, so the switch must diff
+// against the overrides the broker reports to remove them. The UpdateLeafStep
+// runs Terraform core's post-apply consistency check and its empty
+// post-refresh plan proves both overrides are gone.
+func TestIntegration_Topic_AuthoritativeConfiguration_ModeSwitch(t *testing.T) {
+	srv, factories := integration.Setup(t)
+	srv.Topic.SetCreateInjectedConfig("redpanda.remote.write", "true")
+
+	const name = "tfrp-mock-topic-auth-switch"
+	declared := "  configuration = {\n    \"retention.ms\" = \"86400000\"\n  }\n"
+	additive := mockTopicCreateConfig(name, "bufnet", 3, 1, declared, true)
+	authoritative := mockTopicCreateConfig(name, "bufnet", 3, 1, "  configuration_mode = \"authoritative\"\n"+declared, true)
+
+	checks := func() []statecheck.StateCheck {
+		return []statecheck.StateCheck{
+			statecheck.ExpectKnownValue(topicAddr, tfjsonpath.New("configuration"), knownvalue.MapExact(map[string]knownvalue.Check{
+				"retention.ms": knownvalue.StringExact("86400000"),
+			})),
+		}
+	}
+
+	switchStep := integration.UpdateLeafStep(topicAddr, authoritative, checks())
+	switchStep.PreConfig = func() {
+		srv.Topic.SetConfig(name, "redpanda.remote.read", "true")
+	}
+	switchStep.Check = func(*terraform.State) error {
+		res, err := srv.Topic.GetTopicConfigurations(context.Background(), &dataplanev1.GetTopicConfigurationsRequest{TopicName: name})
+		if err != nil {
+			return err
+		}
+		for _, c := range res.GetConfigurations() {
+			if c.GetName() != "retention.ms" {
+				return fmt.Errorf("undeclared override %s=%s was not removed", c.GetName(), c.GetValue())
+			}
+		}
+		return nil
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			integration.CreateStep(topicAddr, additive, checks()),
+			switchStep,
+		},
+	})
+}
+
 // TestIntegration_Topic_UpdateLeaf_AllowDeletion flips allow_deletion true→false→true.
 // The Update path skips both SetTopicConfigurations (configuration unchanged)
 // and SetTopicPartitions (partition_count unchanged) on these flips; only
@@ -457,15 +539,15 @@ func TestIntegration_Topic_RequiresReplace_ClusterApiUrl(t *testing.T) {
 	})
 }
 
-// TestIntegration_Topic_RequiresReplace_ReplicationFactor mutates replication_factor
-// 1→2. Both values satisfy the -1..5 proto-validate constraint. id stays
-// the same because id=name and name is unchanged; the plancheck is the
-// load-bearing proof of replacement.
-func TestIntegration_Topic_RequiresReplace_ReplicationFactor(t *testing.T) {
+// TestIntegration_Topic_Update_ReplicationFactor mutates replication_factor
+// 1→2. Both values satisfy the -1..5 proto-validate constraint. The change
+// goes through UpdateTopicConfigurations in place; the Update plancheck is
+// the load-bearing proof that the topic is not recreated.
+func TestIntegration_Topic_Update_ReplicationFactor(t *testing.T) {
 	_, factories := integration.Setup(t)
 
-	cfgA := mockTopicCreateConfig("tfrp-mock-topic-rr-rf", "bufnet", 3, 1, "", true)
-	cfgB := mockTopicCreateConfig("tfrp-mock-topic-rr-rf", "bufnet", 3, 2, "", true)
+	cfgA := mockTopicCreateConfig("tfrp-mock-topic-up-rf", "bufnet", 3, 1, "", true)
+	cfgB := mockTopicCreateConfig("tfrp-mock-topic-up-rf", "bufnet", 3, 2, "", true)
 
 	idStable := statecheck.CompareValue(compare.ValuesSame())
 
@@ -476,7 +558,7 @@ func TestIntegration_Topic_RequiresReplace_ReplicationFactor(t *testing.T) {
 				statecheck.ExpectKnownValue(topicAddr, tfjsonpath.New("replication_factor"), knownvalue.NumberExact(bigFloat(1))),
 				idStable.AddStateValue(topicAddr, tfjsonpath.New("id")),
 			}),
-			integration.RequiresReplaceStep(topicAddr, cfgB, []statecheck.StateCheck{
+			integration.UpdateLeafStep(topicAddr, cfgB, []statecheck.StateCheck{
 				statecheck.ExpectKnownValue(topicAddr, tfjsonpath.New("replication_factor"), knownvalue.NumberExact(bigFloat(2))),
 				idStable.AddStateValue(topicAddr, tfjsonpath.New("id")),
 			}),
@@ -606,11 +688,11 @@ func TestIntegration_Topic_CreateAndRefresh_ReplicaAssignments(t *testing.T) {
 	})
 }
 
-// TestIntegration_Topic_RequiresReplace_ReplicaAssignments mutates a
-// replica_assignments entry's replica_ids. The ListNestedAttribute carries
-// RequiresReplace, so any change triggers DestroyBeforeCreate. id is
-// unchanged (id=name, name unchanged); the plancheck is the proof.
-func TestIntegration_Topic_RequiresReplace_ReplicaAssignments(t *testing.T) {
+// TestIntegration_Topic_ReplicaAssignments_ChangeRejected mutates a
+// replica_assignments entry's replica_ids. The Data Plane API cannot move
+// partitions to specific brokers, so ModifyPlan rejects the change instead
+// of recreating the topic and losing its data.
+func TestIntegration_Topic_ReplicaAssignments_ChangeRejected(t *testing.T) {
 	_, factories := integration.Setup(t)
 
 	cfgA := mockTopicReplicaAssignmentsConfig("tfrp-mock-topic-rr-ra", []topicRAEntry{
@@ -620,8 +702,6 @@ func TestIntegration_Topic_RequiresReplace_ReplicaAssignments(t *testing.T) {
 		{partitionID: 0, replicaIDs: []int{1, 3}},
 	})
 
-	idStable := statecheck.CompareValue(compare.ValuesSame())
-
 	resource.UnitTest(t, resource.TestCase{
 		ProtoV6ProviderFactories: factories,
 		Steps: []resource.TestStep{
@@ -630,15 +710,11 @@ func TestIntegration_Topic_RequiresReplace_ReplicaAssignments(t *testing.T) {
 					knownvalue.Int32Exact(1),
 					knownvalue.Int32Exact(2),
 				})),
-				idStable.AddStateValue(topicAddr, tfjsonpath.New("id")),
-			}),
-			integration.RequiresReplaceStep(topicAddr, cfgB, []statecheck.StateCheck{
-				statecheck.ExpectKnownValue(topicAddr, tfjsonpath.New("replica_assignments").AtSliceIndex(0).AtMapKey("replica_ids"), knownvalue.ListExact([]knownvalue.Check{
-					knownvalue.Int32Exact(1),
-					knownvalue.Int32Exact(3),
-				})),
-				idStable.AddStateValue(topicAddr, tfjsonpath.New("id")),
 			}),
+			{
+				Config:      cfgB,
+				ExpectError: regexp.MustCompile("Unsupported replica_assignments change"),
+			},
 		},
 	})
 }
@@ -0,0 +1,417 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var (
+	_ resource.Resource                = &ReadReplicaTopic{}
+	_ resource.ResourceWithConfigure   = &ReadReplicaTopic{}
+	_ resource.ResourceWithImportState = &ReadReplicaTopic{}
+)
+
+// readReplicaConfig is the topic property that makes a topic a read replica
+// of the topic with the same name in the given object storage bucket.
+const readReplicaConfig = "redpanda.remote.readreplica"
+
+// clusterLookup resolves the clusters on both sides of a read replica topic;
+// *cloud.ControlPlaneClientSet implements it.
+type clusterLookup interface {
+	clusterResolver
+	ClusterForID(ctx context.Context, id string) (*controlplanev1.Cluster, error)
+}
+
+// ReadReplicaTopic represents the read replica topic Terraform resource: a
+// read-only copy of a topic on another cluster, served from that cluster's
+// object storage bucket.
+type ReadReplicaTopic struct {
+	base.ResourceBase
+
+	TopicClient dataplanev1grpc.TopicServiceClient
+
+	resData config.Resource
+}
+
+// NewReadReplicaTopic constructs a ReadReplicaTopic resource.
+func NewReadReplicaTopic() *ReadReplicaTopic {
+	r := &ReadReplicaTopic{}
+	r.ResourceBase = base.NewResourceBase(
+		"redpanda_read_replica_topic",
+		ResourceReadReplicaTopicSchema,
+		func(p config.Resource) { r.resData = p },
+	)
+	return r
+}
+
+// ResourceReadReplicaTopicSchema returns the schema for the ReadReplicaTopic
+// resource.
+func ResourceReadReplicaTopicSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Creates a read replica topic: a read-only copy of a topic on another cluster, served from that cluster's object storage bucket",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description:   "Name of the topic. Must match the name of the topic on the source cluster.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.LengthBetween(1, 249)},
+			},
+			"cluster_api_url": schema.StringAttribute{
+				Description:   "The cluster API URL of the cluster the read replica topic is created on. The cluster must be listed in the `read_replica_cluster_ids` of the source cluster.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"source_cluster_id": schema.StringAttribute{
+				Description: "ID of the cluster that owns the topic. The read replica topic is only created when the cluster behind `cluster_api_url` is listed in this cluster's `read_replica_cluster_ids`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIf(
+					sourceClusterRequiresReplace,
+					"Changing the source cluster requires recreating the read replica topic",
+					"Changing the source cluster requires recreating the read replica topic",
+				)},
+			},
+			"source_bucket": schema.StringAttribute{
+				Description:   "Object storage bucket of the source cluster, written to the `redpanda.remote.readreplica` topic property. Defaults to the bucket reported for `source_cluster_id`; set it when the source cluster does not report one.",
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown(), stringplanmodifier.RequiresReplace()},
+			},
+			"allow_deletion": schema.BoolAttribute{
+				Description: "Whether Terraform may destroy this resource. Defaults to false. Deleting a read replica topic does not delete any data of the source topic.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Description:   "Unique identifier of the resource.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// sourceClusterRequiresReplace replaces the topic when source_cluster_id
+// changes, but not when it is first set after import.
+func sourceClusterRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+// Create creates a ReadReplicaTopic resource.
+func (r *ReadReplicaTopic) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan topicmodel.ReadReplicaModel
+	var configured types.String
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("source_bucket"), &configured)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var clusters clusterLookup
+	if r.CpCl != nil {
+		clusters = r.CpCl
+	}
+	bucket, diags := readReplicaBucket(ctx, clusters, plan.SourceClusterID.ValueString(), plan.ClusterAPIURL.ValueString(), configured)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createTopicClient(ctx, plan.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create topic client", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	name := plan.Name.ValueString()
+	createReq := &dataplanev1.CreateTopicRequest{
+		Topic: &dataplanev1.CreateTopicRequest_Topic{
+			Name:    name,
+			Configs: []*dataplanev1.CreateTopicRequest_Topic_Config{{Name: readReplicaConfig, Value: &bucket}},
+		},
+	}
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		_, createErr := r.TopicClient.CreateTopic(ctx, createReq)
+		if createErr != nil {
+			if isAlreadyExistsError(createErr) {
+				return utils.NonRetryableError(createErr)
+			}
+			if isTransientBrokerError(createErr) {
+				if _, findErr := utils.FindTopicByName(ctx, name, r.TopicClient); findErr == nil {
+					return nil
+				}
+				return utils.RetryableError(fmt.Errorf("transient broker error, retrying: %w", createErr))
+			}
+			return utils.NonRetryableError(createErr)
+		}
+		return nil
+	})
+	if err != nil {
+		if isAlreadyExistsError(err) {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("Failed to create read replica topic; topic %q already exists", name),
+				"Read replica topics can be imported using 'terraform import redpanda_read_replica_topic.<resource_name> <topic_name>,<cluster_id>'",
+			)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to create read replica topic %q", name), utils.DeserializeGrpcError(err))
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+	plan.SourceBucket = types.StringValue(bucket)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read reads the state of the ReadReplicaTopic resource.
+func (r *ReadReplicaTopic) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state topicmodel.ReadReplicaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ClusterAPIURL.IsNull() || state.ClusterAPIURL.IsUnknown() || state.ClusterAPIURL.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	name := state.Name.ValueString()
+	if err := r.createTopicClient(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		action, diags := utils.HandleGracefulRemoval(ctx, "read replica topic", name, state.AllowDeletion, err, "create topic client")
+		resp.Diagnostics.Append(diags...)
+		if action == utils.RemoveFromState {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		if _, findErr := utils.FindTopicByName(ctx, name, r.TopicClient); findErr != nil {
+			if isTransientBrokerError(findErr) {
+				return utils.RetryableError(findErr)
+			}
+			return utils.NonRetryableError(findErr)
+		}
+		return nil
+	})
+	if err != nil {
+		action, diags := utils.HandleGracefulRemoval(ctx, "read replica topic", name, state.AllowDeletion, err, "find topic")
+		resp.Diagnostics.Append(diags...)
+		if action == utils.RemoveFromState {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+
+	var cfgRes *dataplanev1.GetTopicConfigurationsResponse
+	err = utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		var cfgErr error
+		cfgRes, cfgErr = r.TopicClient.GetTopicConfigurations(ctx, &dataplanev1.GetTopicConfigurationsRequest{TopicName: name})
+		if cfgErr != nil {
+			if isTransientBrokerError(cfgErr) {
+				return utils.RetryableError(cfgErr)
+			}
+			return utils.NonRetryableError(cfgErr)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to retrieve %q topic configuration", name), utils.DeserializeGrpcError(err))
+		return
+	}
+	for _, c := range cfgRes.GetConfigurations() {
+		if c.GetName() == readReplicaConfig && c.Value != nil {
+			state.SourceBucket = types.StringValue(c.GetValue())
+		}
+	}
+	state.ID = types.StringValue(name)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update records changes that need no call to the cluster: allow_deletion,
+// and source_cluster_id when it is first set after import.
+func (*ReadReplicaTopic) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan topicmodel.ReadReplicaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the ReadReplicaTopic resource. The source topic and its data
+// in object storage are left untouched.
+func (r *ReadReplicaTopic) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state topicmodel.ReadReplicaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if !state.AllowDeletion.IsNull() && !state.AllowDeletion.ValueBool() {
+		resp.Diagnostics.AddError(fmt.Sprintf("read replica topic %s does not allow deletion", name), "allow_deletion is set to false")
+		return
+	}
+	if err := r.createTopicClient(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		_, diags := utils.HandleGracefulRemoval(ctx, "read replica topic", name, state.AllowDeletion, err, "create topic client")
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		_, delErr := r.TopicClient.DeleteTopic(ctx, &dataplanev1.DeleteTopicRequest{TopicName: name})
+		if delErr != nil {
+			if isNotFoundError(delErr) {
+				return nil
+			}
+			if isTransientBrokerError(delErr) {
+				return utils.RetryableError(delErr)
+			}
+			return utils.NonRetryableError(delErr)
+		}
+		return nil
+	})
+	if err != nil {
+		_, diags := utils.HandleGracefulRemoval(ctx, "read replica topic", name, state.AllowDeletion, err, "delete topic")
+		resp.Diagnostics.Append(diags...)
+	}
+}
+
+// ImportState imports a ReadReplicaTopic via "<topic_name>,<cluster_id>",
+// where the cluster is the one holding the read replica. source_cluster_id
+// is not known after import and is recorded on the next apply.
+func (r *ReadReplicaTopic) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	topicName, clusterID, ok := utils.SplitImportID(req.ID, ",")
+	if !ok {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ID format: %v", req.ID), "ID format is <topic_name>,<cluster_id>")
+		return
+	}
+	dataplaneURL, err := r.CpCl.DataplaneURLForCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to resolve dataplane URL for cluster %q; make sure ID format is <topic_name>,<cluster_id>", clusterID),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), types.StringValue(topicName))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(topicName))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_api_url"), types.StringValue(dataplaneURL))...)
+	resp.Diagnostics.Append(utils.ImportStateBoolFromSchemaDefault(ctx, ResourceReadReplicaTopicSchema(ctx), &resp.State, "allow_deletion")...)
+}
+
+func (r *ReadReplicaTopic) createTopicClient(ctx context.Context, clusterURL string) error {
+	if r.TopicClient != nil {
+		return nil
+	}
+	client, err := utils.NewDataplaneClient(ctx, r.resData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewTopicServiceClient)
+	if err != nil {
+		return err
+	}
+	r.TopicClient = client
+	return nil
+}
+
+// readReplicaBucket returns the bucket a read replica topic on the cluster
+// behind apiURL reads from. It fails when that cluster is not listed in the
+// source cluster's read_replica_cluster_ids, since Redpanda would otherwise
+// create a topic that never receives data. configured, when set, overrides
+// the bucket reported for the source cluster. Without clusters, only a
+// configured bucket can be used.
+func readReplicaBucket(ctx context.Context, clusters clusterLookup, sourceClusterID, apiURL string, configured types.String) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	bucket := configured.ValueString()
+	if clusters == nil {
+		if bucket == "" {
+			diags.AddAttributeError(path.Root("source_bucket"), "Cannot determine the source bucket",
+				"The source cluster cannot be looked up; set source_bucket to the object storage bucket of the source cluster.")
+		}
+		return bucket, diags
+	}
+	source, err := clusters.ClusterForID(ctx, sourceClusterID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source_cluster_id"), fmt.Sprintf("failed to look up source cluster %q", sourceClusterID), utils.DeserializeGrpcError(err))
+		return "", diags
+	}
+	replica, err := clusters.ClusterForAPIURL(ctx, apiURL)
+	if err != nil {
+		diags.AddAttributeError(path.Root("cluster_api_url"), "failed to look up the read replica cluster", utils.DeserializeGrpcError(err))
+		return "", diags
+	}
+	if replica != nil && !slices.Contains(source.GetReadReplicaClusterIds(), replica.GetId()) {
+		diags.AddAttributeError(path.Root("source_cluster_id"), "Cluster is not a read replica of the source cluster",
+			fmt.Sprintf("Add %q (%s) to read_replica_cluster_ids of redpanda_cluster %q (%s) before creating read replica topics on it.",
+				replica.GetId(), replica.GetName(), source.GetName(), source.GetId()))
+		return "", diags
+	}
+	if bucket == "" {
+		bucket = clusterBucket(source)
+	}
+	if bucket == "" {
+		diags.AddAttributeError(path.Root("source_bucket"), "Cannot determine the source bucket",
+			fmt.Sprintf("Cluster %q (%s) does not report an object storage bucket; set source_bucket.", source.GetName(), source.GetId()))
+	}
+	return bucket, diags
+}
+
+// clusterBucket returns the name of the object storage bucket a cluster
+// uploads its topics to, or "" when the cluster does not report one.
+func clusterBucket(cl *controlplanev1.Cluster) string {
+	storage := cl.GetCloudStorage()
+	cmr := cl.GetCustomerManagedResources()
+	for _, name := range []string{
+		s3BucketName(storage.GetAws().GetArn()),
+		storage.GetGcp().GetName(),
+		storage.GetAzure().GetContainerName(),
+		s3BucketName(cmr.GetAws().GetCloudStorageBucket().GetArn()),
+		cmr.GetGcp().GetTieredStorageBucket().GetName(),
+	} {
+		if name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// s3BucketName returns the bucket name of an S3 bucket ARN such as
+// arn:aws:s3:::redpanda-cloud-storage-cq1k5aq2e1l3jv3vkc5g.
+func s3BucketName(arn string) string {
+	if i := strings.LastIndex(arn, ":"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
@@ -0,0 +1,188 @@
+package topic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+const testReplicaAPIURL = "https://api-a1b2c3d4.cr2k6bq3f2m4kv4vld6h.byoc.prd.cloud.redpanda.com"
+
+func readReplicaState(bucket types.String) topicmodel.ReadReplicaModel {
+	return topicmodel.ReadReplicaModel{
+		AllowDeletion:   types.BoolValue(false),
+		ClusterAPIURL:   types.StringValue(testReplicaAPIURL),
+		ID:              types.StringValue("orders"),
+		Name:            types.StringValue("orders"),
+		SourceBucket:    bucket,
+		SourceClusterID: types.StringValue("cq1k5aq2e1l3jv3vkc5g"),
+	}
+}
+
+func TestUnit_ReadReplicaTopic_Create(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+	mockClient.EXPECT().
+		CreateTopic(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.CreateTopicRequest, _ ...grpc.CallOption) (*dataplanev1.CreateTopicResponse, error) {
+			require.Len(t, req.GetTopic().GetConfigs(), 1)
+			assert.Equal(t, "orders", req.GetTopic().GetName())
+			assert.Equal(t, readReplicaConfig, req.GetTopic().GetConfigs()[0].GetName())
+			assert.Equal(t, "redpanda-cloud-storage-origin", req.GetTopic().GetConfigs()[0].GetValue())
+			assert.Nil(t, req.GetTopic().PartitionCount, "the partition count comes from the source topic")
+			return &dataplanev1.CreateTopicResponse{TopicName: "orders"}, nil
+		})
+
+	r := &ReadReplicaTopic{TopicClient: mockClient}
+	s := ResourceReadReplicaTopicSchema(ctx)
+	plan := readReplicaState(types.StringValue("redpanda-cloud-storage-origin"))
+	plan.ID = types.StringUnknown()
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	req.Config = tfsdk.Config{Schema: s, Raw: req.Plan.Raw}
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+
+	r.Create(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state topicmodel.ReadReplicaModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "orders", state.ID.ValueString())
+	assert.Equal(t, "redpanda-cloud-storage-origin", state.SourceBucket.ValueString())
+}
+
+func TestUnit_ReadReplicaTopic_Read_RefreshesBucket(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+	mockClient.EXPECT().
+		ListTopics(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.ListTopicsResponse{
+			Topics: []*dataplanev1.ListTopicsResponse_Topic{{Name: "orders", PartitionCount: 6, ReplicationFactor: 3}},
+		}, nil)
+	mockClient.EXPECT().
+		GetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.GetTopicConfigurationsResponse{
+			Configurations: []*dataplanev1.Topic_Configuration{
+				{Name: "retention.ms", Value: strPtr("604800000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+				{Name: readReplicaConfig, Value: strPtr("other-bucket"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+			},
+		}, nil)
+
+	r := &ReadReplicaTopic{TopicClient: mockClient}
+	s := ResourceReadReplicaTopicSchema(ctx)
+	prior := readReplicaState(types.StringValue("redpanda-cloud-storage-origin"))
+	req := resource.ReadRequest{State: tfsdk.State{Schema: s}}
+	require.False(t, req.State.Set(ctx, &prior).HasError())
+	resp := resource.ReadResponse{State: tfsdk.State{Schema: s}}
+
+	r.Read(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var state topicmodel.ReadReplicaModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "other-bucket", state.SourceBucket.ValueString(), "a different bucket on the broker shows up as drift")
+	assert.Equal(t, prior.SourceClusterID, state.SourceClusterID)
+}
+
+func TestUnit_ReadReplicaTopic_Delete_NotAllowed(t *testing.T) {
+	ctx := context.Background()
+	r := &ReadReplicaTopic{TopicClient: mocks.NewMockTopicServiceClient(gomock.NewController(t))}
+	s := ResourceReadReplicaTopicSchema(ctx)
+	prior := readReplicaState(types.StringValue("redpanda-cloud-storage-origin"))
+	req := resource.DeleteRequest{State: tfsdk.State{Schema: s}}
+	require.False(t, req.State.Set(ctx, &prior).HasError())
+	resp := resource.DeleteResponse{State: req.State}
+
+	r.Delete(ctx, req, &resp)
+	assert.True(t, resp.Diagnostics.HasError(), "allow_deletion = false must block deletion")
+}
+
+type fakeClusterLookup struct {
+	clusters map[string]*controlplanev1.Cluster
+	replica  *controlplanev1.Cluster
+}
+
+func (f fakeClusterLookup) ClusterForID(_ context.Context, id string) (*controlplanev1.Cluster, error) {
+	if cl, ok := f.clusters[id]; ok {
+		return cl, nil
+	}
+	return nil, errors.New("cluster not found")
+}
+
+func (f fakeClusterLookup) ClusterForAPIURL(context.Context, string) (*controlplanev1.Cluster, error) {
+	return f.replica, nil
+}
+
+func TestUnit_ReadReplicaBucket(t *testing.T) {
+	origin := &controlplanev1.Cluster{
+		Id:                    "cq1k5aq2e1l3jv3vkc5g",
+		Name:                  "origin",
+		ReadReplicaClusterIds: []string{"cr2k6bq3f2m4kv4vld6h"},
+		CloudStorage: &controlplanev1.Cluster_CloudStorage{
+			CloudProvider: &controlplanev1.Cluster_CloudStorage_Aws{
+				Aws: &controlplanev1.Cluster_CloudStorage_AWS{Arn: "arn:aws:s3:::redpanda-cloud-storage-cq1k5aq2e1l3jv3vkc5g"},
+			},
+		},
+	}
+	replica := &controlplanev1.Cluster{Id: "cr2k6bq3f2m4kv4vld6h", Name: "replica"}
+	stranger := &controlplanev1.Cluster{Id: "cs3k7cq4g3n5lv5vme7i", Name: "stranger"}
+	lookup := fakeClusterLookup{clusters: map[string]*controlplanev1.Cluster{origin.Id: origin}, replica: replica}
+
+	tests := []struct {
+		name       string
+		clusters   clusterLookup
+		sourceID   string
+		configured types.String
+		want       string
+		wantErr    string
+	}{
+		{name: "bucket from source cluster", clusters: lookup, sourceID: origin.Id, configured: types.StringNull(), want: "redpanda-cloud-storage-cq1k5aq2e1l3jv3vkc5g"},
+		{name: "configured bucket wins", clusters: lookup, sourceID: origin.Id, configured: types.StringValue("mirror"), want: "mirror"},
+		{name: "unresolved replica is not checked", clusters: fakeClusterLookup{clusters: lookup.clusters}, sourceID: origin.Id, configured: types.StringNull(), want: "redpanda-cloud-storage-cq1k5aq2e1l3jv3vkc5g"},
+		{name: "replica not listed", clusters: fakeClusterLookup{clusters: lookup.clusters, replica: stranger}, sourceID: origin.Id, configured: types.StringNull(), wantErr: "read_replica_cluster_ids"},
+		{name: "unknown source cluster", clusters: lookup, sourceID: "cv0000000000000000aa", configured: types.StringNull(), wantErr: "cluster not found"},
+		{name: "no lookup needs a configured bucket", clusters: nil, sourceID: origin.Id, configured: types.StringNull(), wantErr: "source_bucket"},
+		{name: "no lookup with configured bucket", clusters: nil, sourceID: origin.Id, configured: types.StringValue("mirror"), want: "mirror"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := readReplicaBucket(context.Background(), tt.clusters, tt.sourceID, testReplicaAPIURL, tt.configured)
+			if tt.wantErr != "" {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags.Errors()[0].Summary()+diags.Errors()[0].Detail(), tt.wantErr)
+				return
+			}
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUnit_ClusterBucket(t *testing.T) {
+	assert.Equal(t, "", clusterBucket(nil))
+	assert.Equal(t, "rp-gcp-bucket", clusterBucket(&controlplanev1.Cluster{
+		CloudStorage: &controlplanev1.Cluster_CloudStorage{
+			CloudProvider: &controlplanev1.Cluster_CloudStorage_Gcp{Gcp: &controlplanev1.Cluster_CloudStorage_GCP{Name: "rp-gcp-bucket"}},
+		},
+	}))
+	assert.Equal(t, "tiered", clusterBucket(&controlplanev1.Cluster{
+		CloudStorage: &controlplanev1.Cluster_CloudStorage{
+			CloudProvider: &controlplanev1.Cluster_CloudStorage_Azure_{Azure: &controlplanev1.Cluster_CloudStorage_Azure{ContainerName: "tiered"}},
+		},
+	}))
+	assert.Equal(t, "byoc-bucket", s3BucketName("arn:aws:s3:::byoc-bucket"))
+}
@@ -18,29 +18,51 @@ package topic
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
 	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/cloud"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
 	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/topicconfig"
 	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
-	_ resource.Resource                 = &Topic{}
-	_ resource.ResourceWithConfigure    = &Topic{}
-	_ resource.ResourceWithImportState  = &Topic{}
-	_ resource.ResourceWithUpgradeState = &Topic{}
+	_ resource.Resource                   = &Topic{}
+	_ resource.ResourceWithConfigure      = &Topic{}
+	_ resource.ResourceWithImportState    = &Topic{}
+	_ resource.ResourceWithUpgradeState   = &Topic{}
+	_ resource.ResourceWithModifyPlan     = &Topic{}
+	_ resource.ResourceWithValidateConfig = &Topic{}
 )
 
+// replicationFactorConfig is the topic property Redpanda uses to change the
+// replication factor of an existing topic. It is surfaced through the
+// replication_factor attribute, not through configuration.
+const replicationFactorConfig = "replication.factor"
+
+// storageModeConfig is injected by the broker on every topic, with the value
+// "unset" unless a storage mode was chosen.
+const storageModeConfig = "redpanda.storage.mode"
+
+// configurationModeAuthoritative is the configuration_mode that reports and
+// removes every dynamic override, declared or not.
+const configurationModeAuthoritative = "authoritative"
+
 // ServiceClientFactory is a function type for creating topic service clients.
 // This allows dependency injection for testing.
 type ServiceClientFactory func(clusterURL string, ts oauth2.TokenSource, providerVersion, terraformVersion string) (dataplanev1grpc.TopicServiceClient, error)
@@ -90,6 +112,126 @@ func (*Topic) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader
 	}
 }
 
+// ModifyPlan checks that the cluster has Iceberg enabled when the plan turns
+// Iceberg on for a topic, and rejects changes to replica_assignments and to
+// read-only topic properties on an existing topic. Redpanda only accepts
+// read-only properties when the topic is created, so without this check the
+// mistake would only surface at apply.
+func (t *Topic) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(t.checkIcebergCluster(ctx, req)...)
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(replicaAssignmentsDiags(ctx, req)...)
+	var plan, state types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("configuration"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("configuration"), &state)...)
+	if resp.Diagnostics.HasError() || plan.IsUnknown() {
+		return
+	}
+	for _, key := range changedReadOnlyConfigs(plan, state) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("configuration").AtMapKey(key),
+			"Read-only topic configuration",
+			fmt.Sprintf("%q can only be set when the topic is created and cannot be changed afterwards.", key),
+		)
+	}
+}
+
+// replicaAssignmentsDiags rejects a change to replica_assignments on an
+// existing topic. The Data Plane API has no call for moving partitions to
+// specific brokers, and recreating the topic would lose its data.
+func replicaAssignmentsDiags(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var plan, state types.List
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("replica_assignments"), &plan)...)
+	diags.Append(req.State.GetAttribute(ctx, path.Root("replica_assignments"), &state)...)
+	if diags.HasError() || plan.IsUnknown() || plan.Equal(state) {
+		return diags
+	}
+	diags.AddAttributeError(
+		path.Root("replica_assignments"),
+		"Unsupported replica_assignments change",
+		"replica_assignments cannot be changed on an existing topic: the Data Plane API has no call for moving partitions to specific brokers. "+
+			"Restore the previous assignments and move partitions with `rpk cluster partitions move`, or change replication_factor, which is applied in place.",
+	)
+	return diags
+}
+
+// checkIcebergCluster runs icebergClusterDiags when the plan enables Iceberg
+// on a topic that did not have it enabled before.
+func (t *Topic) checkIcebergCluster(ctx context.Context, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var planned, prior types.Object
+	var clusterURL types.String
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("iceberg"), &planned)...)
+	diags.Append(req.Plan.GetAttribute(ctx, path.Root("cluster_api_url"), &clusterURL)...)
+	if !req.State.Raw.IsNull() {
+		diags.Append(req.State.GetAttribute(ctx, path.Root("iceberg"), &prior)...)
+	}
+	if diags.HasError() || clusterURL.IsUnknown() || !icebergEnabled(planned) || icebergEnabled(prior) {
+		return diags
+	}
+	var resolver clusterResolver
+	if t.CpCl != nil {
+		resolver = t.CpCl
+	}
+	diags.Append(icebergClusterDiags(ctx, resolver, clusterURL.ValueString())...)
+	return diags
+}
+
+// ValidateConfig rejects topic properties that are set both through a
+// block such as iceberg or tiered_storage and through configuration.
+func (*Topic) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var cfg types.Map
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &cfg)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, b := range configBlocks {
+		var obj types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(b.attr), &obj)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		for _, key := range b.conflicts(cfg) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("configuration").AtMapKey(key),
+				"Conflicting topic configuration",
+				fmt.Sprintf("%q is set through the %s attribute; remove it from configuration.", key, b.attr),
+			)
+		}
+	}
+}
+
+// changedReadOnlyConfigs returns the read-only catalog properties whose
+// value differs between plan and state, including ones added or removed.
+// Elements still unknown in the plan are skipped.
+func changedReadOnlyConfigs(plan, state types.Map) []string {
+	var changed []string
+	for _, name := range topicconfig.Names() {
+		p, _ := topicconfig.Lookup(name)
+		if !p.ReadOnly {
+			continue
+		}
+		pv, inPlan := plan.Elements()[name]
+		sv, inState := state.Elements()[name]
+		if inPlan && pv.IsUnknown() {
+			continue
+		}
+		if inPlan != inState || (inPlan && !pv.Equal(sv)) {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
 // Create creates a Topic resource.
 func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	var plan topicmodel.ResourceModel
@@ -98,7 +240,17 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 		return
 	}
 
-	cfg, err := utils.MapToCreateTopicConfiguration(plan.Configuration)
+	planned, diags := withConfigBlocks(&plan)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	normalized, err := topicconfig.NormalizeMap(planned)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("failed to parse topic configuration for %s", plan.Name), err.Error())
+		return
+	}
+	cfg, err := utils.MapToCreateTopicConfiguration(normalized)
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("failed to parse topic configuration for %s", plan.Name), utils.DeserializeGrpcError(err))
 		return
@@ -170,6 +322,16 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 		return
 	}
 
+	// Authoritative mode also owns the overrides the broker adds on its own
+	// when it creates the topic, such as redpanda.remote.write. Without a
+	// declared configuration there is nothing to hold them against.
+	if isAuthoritative(plan.ConfigurationMode) && !plan.Configuration.IsUnknown() {
+		if err := t.alterConfiguration(ctx, topicName, planned); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("failed to reconcile %q topic configuration", topicName), utils.DeserializeGrpcError(err))
+			return
+		}
+	}
+
 	// Configuration sync — separate Get-after-Create RPC, then update state.
 	var tpCfgRes *dataplanev1.GetTopicConfigurationsResponse
 	err = utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
@@ -187,13 +349,10 @@ func (t *Topic) Create(ctx context.Context, request resource.CreateRequest, resp
 		response.Diagnostics.AddError(fmt.Sprintf("failed to retrieve %q topic configuration", state.Name.ValueString()), utils.DeserializeGrpcError(err))
 		return
 	}
-	tpCfg := mergeWithPlannedConfig(filterDynamicConfig(tpCfgRes.Configurations), tpCfgRes.Configurations, plan.Configuration)
-	tpCfgMap, err := utils.TopicConfigurationToMap(tpCfg)
-	if err != nil {
-		response.Diagnostics.AddError("unable to parse the topic configuration", utils.DeserializeGrpcError(err))
+	response.Diagnostics.Append(refreshAppliedConfiguration(ctx, state, tpCfgRes.Configurations)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
-	state.Configuration = tpCfgMap
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -254,18 +413,15 @@ func (t *Topic) Read(ctx context.Context, request resource.ReadRequest, response
 		response.Diagnostics.AddError(fmt.Sprintf("failed to retrieve %q topic configuration", tp.Name), utils.DeserializeGrpcError(err))
 		return
 	}
-	tpCfg := mergeWithPlannedConfig(filterDynamicConfig(tpCfgRes.Configurations), tpCfgRes.Configurations, model.Configuration)
-	topicCfg, err := utils.TopicConfigurationToMap(tpCfg)
-	if err != nil {
-		response.Diagnostics.AddError("unable to parse the topic configuration", utils.DeserializeGrpcError(err))
-		return
-	}
 	state, flatDiags := topicmodel.Flatten(ctx, listTopicToFlattenInput(tp), &model)
 	response.Diagnostics.Append(flatDiags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
-	state.Configuration = topicCfg
+	response.Diagnostics.Append(refreshConfiguration(ctx, state, tpCfgRes.Configurations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
 
@@ -280,25 +436,23 @@ func (t *Topic) Update(ctx context.Context, request resource.UpdateRequest, resp
 		return
 	}
 
-	if !plan.Configuration.Equal(state.Configuration) {
-		cfgToSet, err := utils.MapToSetTopicConfiguration(plan.Configuration)
-		if err != nil {
-			response.Diagnostics.AddError("unable to parse the plan topic configuration", utils.DeserializeGrpcError(err))
+	// Authoritative mode always reconciles with the broker: an unchanged
+	// configuration can still have overrides to remove, for instance right
+	// after switching from additive mode, which leaves redpanda.* keys out of
+	// state.
+	authoritative := isAuthoritative(plan.ConfigurationMode)
+	if authoritative || !plan.Configuration.Equal(state.Configuration) || configBlocksChanged(&plan, &state) {
+		planned, diags := withConfigBlocks(&plan)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
 			return
 		}
-		err = utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
-			_, setErr := t.TopicClient.SetTopicConfigurations(ctx, &dataplanev1.SetTopicConfigurationsRequest{
-				TopicName:      plan.Name.ValueString(),
-				Configurations: cfgToSet,
-			})
-			if setErr != nil {
-				if isTransientBrokerError(setErr) {
-					return utils.RetryableError(setErr)
-				}
-				return utils.NonRetryableError(setErr)
-			}
-			return nil
-		})
+		var err error
+		if authoritative {
+			err = t.alterConfiguration(ctx, plan.Name.ValueString(), planned)
+		} else {
+			err = t.setConfiguration(ctx, plan.Name.ValueString(), planned)
+		}
 		if err != nil {
 			response.Diagnostics.AddError("failed to update topic configuration", utils.DeserializeGrpcError(err))
 			return
@@ -327,6 +481,13 @@ func (t *Topic) Update(ctx context.Context, request resource.UpdateRequest, resp
 		}
 	}
 
+	if replicationFactorChanged(plan.ReplicationFactor, state.ReplicationFactor) {
+		if err := setReplicationFactor(ctx, t.TopicClient, plan.Name.ValueString(), *utils.NumberToInt32(plan.ReplicationFactor)); err != nil {
+			response.Diagnostics.AddError("failed to update replication factor", utils.DeserializeGrpcError(err))
+			return
+		}
+	}
+
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
@@ -348,13 +509,10 @@ func (t *Topic) Update(ctx context.Context, request resource.UpdateRequest, resp
 		response.Diagnostics.AddError(fmt.Sprintf("failed to retrieve %q topic configuration after update", plan.Name.ValueString()), utils.DeserializeGrpcError(err))
 		return
 	}
-	tpCfg := mergeWithPlannedConfig(filterDynamicConfig(tpCfgRes.Configurations), tpCfgRes.Configurations, plan.Configuration)
-	tpCfgMap, err := utils.TopicConfigurationToMap(tpCfg)
-	if err != nil {
-		response.Diagnostics.AddError("unable to parse the topic configuration", utils.DeserializeGrpcError(err))
+	response.Diagnostics.Append(refreshAppliedConfiguration(ctx, &plan, tpCfgRes.Configurations)...)
+	if response.Diagnostics.HasError() {
 		return
 	}
-	plan.Configuration = tpCfgMap
 	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
 }
 
@@ -445,6 +603,221 @@ func (t *Topic) createTopicClient(ctx context.Context, clusterURL string) error
 	return nil
 }
 
+// setConfiguration replaces the topic's dynamic configuration with the
+// planned map in a single SetTopicConfigurations call. Keys missing from the
+// plan revert to their defaults.
+func (t *Topic) setConfiguration(ctx context.Context, topicName string, planned types.Map) error {
+	normalized, err := topicconfig.NormalizeMap(planned)
+	if err != nil {
+		return err
+	}
+	cfgToSet, err := utils.MapToSetTopicConfiguration(normalized)
+	if err != nil {
+		return err
+	}
+	return utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		_, setErr := t.TopicClient.SetTopicConfigurations(ctx, &dataplanev1.SetTopicConfigurationsRequest{
+			TopicName:      topicName,
+			Configurations: cfgToSet,
+		})
+		if setErr != nil {
+			if isTransientBrokerError(setErr) {
+				return utils.RetryableError(setErr)
+			}
+			return utils.NonRetryableError(setErr)
+		}
+		return nil
+	})
+}
+
+// alterConfiguration brings the topic's dynamic overrides to the planned
+// configuration with incremental set and delete operations, so only the keys
+// that differ are touched. The difference is taken against the overrides the
+// broker reports rather than prior state, which lacks overrides made since
+// the last refresh and, after a switch from additive mode, redpanda.* keys.
+// An unknown plan leaves the topic alone.
+func (t *Topic) alterConfiguration(ctx context.Context, topicName string, planned types.Map) error {
+	if planned.IsUnknown() {
+		return nil
+	}
+	var res *dataplanev1.GetTopicConfigurationsResponse
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		var cfgErr error
+		res, cfgErr = t.TopicClient.GetTopicConfigurations(ctx, &dataplanev1.GetTopicConfigurationsRequest{TopicName: topicName})
+		if cfgErr != nil {
+			if isTransientBrokerError(cfgErr) {
+				return utils.RetryableError(cfgErr)
+			}
+			return utils.NonRetryableError(cfgErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	ops, err := configurationAlterations(liveConfiguration(res.GetConfigurations(), planned), planned)
+	if err != nil || len(ops) == 0 {
+		return err
+	}
+	return utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		_, setErr := t.TopicClient.UpdateTopicConfigurations(ctx, &dataplanev1.UpdateTopicConfigurationsRequest{
+			TopicName:      topicName,
+			Configurations: ops,
+		})
+		if setErr != nil {
+			if isTransientBrokerError(setErr) {
+				return utils.RetryableError(setErr)
+			}
+			return utils.NonRetryableError(setErr)
+		}
+		return nil
+	})
+}
+
+// liveConfiguration returns the dynamic overrides in all that authoritative
+// mode reconciles. Keys untrackedConfig always leaves out are skipped, and so
+// are undeclared read-only properties, which Redpanda does not let anyone
+// remove.
+func liveConfiguration(all []*dataplanev1.Topic_Configuration, planned types.Map) types.Map {
+	elems := make(map[string]attr.Value, len(all))
+	for _, cfg := range filterDynamicConfig(all) {
+		if untrackedConfig(cfg, true) {
+			continue
+		}
+		if _, declared := planned.Elements()[cfg.GetName()]; !declared {
+			if p, ok := topicconfig.Lookup(cfg.GetName()); ok && p.ReadOnly {
+				continue
+			}
+		}
+		elems[cfg.GetName()] = types.StringValue(cfg.GetValue())
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+// configurationAlterations diffs two configuration maps after unit
+// normalisation: keys that are new or whose value changed become SET
+// operations and keys missing from planned become DELETE operations.
+// Operations are sorted by key.
+func configurationAlterations(prior, planned types.Map) ([]*dataplanev1.UpdateTopicConfigurationsRequest_UpdateConfiguration, error) {
+	from, err := configurationValues(prior)
+	if err != nil {
+		return nil, err
+	}
+	to, err := configurationValues(planned)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range to {
+		keys = append(keys, k)
+	}
+	for k := range from {
+		if _, ok := to[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var ops []*dataplanev1.UpdateTopicConfigurationsRequest_UpdateConfiguration
+	for _, k := range keys {
+		v, inPlan := to[k]
+		switch old, inPrior := from[k]; {
+		case !inPlan:
+			ops = append(ops, &dataplanev1.UpdateTopicConfigurationsRequest_UpdateConfiguration{
+				Name:      k,
+				Operation: dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_DELETE,
+			})
+		case !inPrior || old != v:
+			ops = append(ops, &dataplanev1.UpdateTopicConfigurationsRequest_UpdateConfiguration{
+				Name:      k,
+				Value:     &v,
+				Operation: dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_SET,
+			})
+		}
+	}
+	return ops, nil
+}
+
+// configurationValues returns the normalised string values of a
+// configuration map. Null and unknown maps yield no values.
+func configurationValues(cfg types.Map) (map[string]string, error) {
+	normalized, err := topicconfig.NormalizeMap(cfg)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]string, len(normalized.Elements()))
+	for k, v := range normalized.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			return nil, fmt.Errorf("topic configuration %q must have a value", k)
+		}
+		out[k] = s.ValueString()
+	}
+	return out, nil
+}
+
+// isAuthoritative reports whether configuration_mode asks for every dynamic
+// override to be tracked.
+func isAuthoritative(mode types.String) bool {
+	return mode.ValueString() == configurationModeAuthoritative
+}
+
+// setReplicationFactor changes the replication factor of an existing topic
+// through the replication.factor topic property, then polls the topic until
+// the broker reports the new replication factor. The broker reports it as
+// soon as the property is set; Redpanda moves the replicas in the background
+// and the Data Plane API does not report that progress, so the poll does not
+// wait for the data to move.
+func setReplicationFactor(ctx context.Context, client dataplanev1grpc.TopicServiceClient, topicName string, replicationFactor int32) error {
+	value := strconv.Itoa(int(replicationFactor))
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		_, setErr := client.UpdateTopicConfigurations(ctx, &dataplanev1.UpdateTopicConfigurationsRequest{
+			TopicName: topicName,
+			Configurations: []*dataplanev1.UpdateTopicConfigurationsRequest_UpdateConfiguration{{
+				Name:      replicationFactorConfig,
+				Value:     &value,
+				Operation: dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_SET,
+			}},
+		})
+		if setErr != nil {
+			if isTransientBrokerError(setErr) {
+				return utils.RetryableError(setErr)
+			}
+			return utils.NonRetryableError(setErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	// Each poll has to list the topics again rather than be served the
+	// listing shared with other resources.
+	pollCtx := cloud.BypassListCache(ctx)
+	return utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		tp, findErr := utils.FindTopicByName(pollCtx, topicName, client)
+		if findErr != nil {
+			if isTransientBrokerError(findErr) {
+				return utils.RetryableError(findErr)
+			}
+			return utils.NonRetryableError(findErr)
+		}
+		if tp.GetReplicationFactor() != replicationFactor {
+			return utils.RetryableError(fmt.Errorf("topic %q is at replication factor %d, waiting for %d", topicName, tp.GetReplicationFactor(), replicationFactor))
+		}
+		return nil
+	})
+}
+
+// replicationFactorChanged reports whether Update has to move replicas. A
+// replication factor of -1 (manual replica_assignments) or a value the
+// broker chooses (null/unknown) never triggers an in-place change.
+func replicationFactorChanged(plan, state types.Number) bool {
+	if plan.IsNull() || plan.IsUnknown() || plan.Equal(state) {
+		return false
+	}
+	return *utils.NumberToInt32(plan) > 0
+}
+
 // flattenInputAfterCreate normalizes the post-create proto state into a
 // *CreateTopicRequest_Topic — the type the generated Flatten consumes.
 // Uses the CreateTopic response when available; otherwise re-reads the
@@ -506,19 +879,60 @@ func filterDynamicConfig(configs []*dataplanev1.Topic_Configuration) []*dataplan
 	return filtered
 }
 
+// refreshConfiguration replaces m.Configuration and the blocks that set
+// topic properties, which hold the planned or prior values, with what the
+// broker reports in all.
+func refreshConfiguration(ctx context.Context, m *topicmodel.ResourceModel, all []*dataplanev1.Topic_Configuration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	cfg := mergeWithPlannedConfig(filterDynamicConfig(all), all, m.Configuration, isAuthoritative(m.ConfigurationMode))
+	cfgMap, err := utils.TopicConfigurationToMap(withoutConfigBlocks(m, cfg))
+	if err != nil {
+		diags.AddError("unable to parse the topic configuration", utils.DeserializeGrpcError(err))
+		return diags
+	}
+	for _, b := range configBlocks {
+		obj, d := b.flatten(ctx, all, *b.field(m))
+		diags.Append(d...)
+		*b.field(m) = obj
+	}
+	m.Configuration = cfgMap
+	return diags
+}
+
+// refreshAppliedConfiguration is refreshConfiguration for the state recorded
+// by Create and Update, which Terraform requires to match the plan. When the
+// plan declares configuration, undeclared overrides the broker reports, such
+// as redpanda.* keys it set while applying, are left out; the next refresh
+// reports them as drift.
+func refreshAppliedConfiguration(ctx context.Context, m *topicmodel.ResourceModel, all []*dataplanev1.Topic_Configuration) diag.Diagnostics {
+	planned := m.Configuration
+	diags := refreshConfiguration(ctx, m, all)
+	if diags.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return diags
+	}
+	elems := make(map[string]attr.Value, len(planned.Elements()))
+	for k, v := range m.Configuration.Elements() {
+		if _, declared := planned.Elements()[k]; declared {
+			elems[k] = v
+		}
+	}
+	cfg, d := types.MapValue(types.StringType, elems)
+	diags.Append(d...)
+	m.Configuration = cfg
+	return diags
+}
+
 // mergeWithPlannedConfig ensures that any configuration keys the user
 // explicitly set in their Terraform config are preserved in the result, even
 // if the server reports them with a non-dynamic source (e.g. when the user-set
 // value matches the server default). Without this, Terraform sees the key
 // "vanish" and reports an inconsistent result after apply.
 //
-// Also strips server-injected `redpanda.*` config keys the user did not name
-// in their plan. After v26.1.1, the broker injects redpanda.storage.mode =
-// "unset" on every topic; left in state, plan-twice would try to remove the
-// key and the server rejects (the property has no null representation, only
-// local/tiered/cloud/unset). Same shape as tagsFromProto in
-// redpanda/models/cluster/conv.go.
-func mergeWithPlannedConfig(dynamicConfigs, allConfigs []*dataplanev1.Topic_Configuration, planned types.Map) []*dataplanev1.Topic_Configuration {
+// Undeclared dynamic keys are dropped when untrackedConfig says so; see
+// there for how configuration_mode changes what is reported. Values the
+// broker reports in normalised units (`604800000` for a planned `7d`) keep
+// the planned spelling.
+func mergeWithPlannedConfig(dynamicConfigs, allConfigs []*dataplanev1.Topic_Configuration, planned types.Map, authoritative bool) []*dataplanev1.Topic_Configuration {
 	plannedKeys := make(map[string]bool, len(planned.Elements()))
 	if !planned.IsNull() && !planned.IsUnknown() {
 		for key := range planned.Elements() {
@@ -531,10 +945,10 @@ func mergeWithPlannedConfig(dynamicConfigs, allConfigs []*dataplanev1.Topic_Conf
 		if cfg == nil {
 			continue
 		}
-		if strings.HasPrefix(cfg.Name, "redpanda.") && !plannedKeys[cfg.Name] {
+		if !plannedKeys[cfg.Name] && untrackedConfig(cfg, authoritative) {
 			continue
 		}
-		filtered = append(filtered, cfg)
+		filtered = append(filtered, keepPlannedSpelling(cfg, planned))
 	}
 
 	if len(plannedKeys) == 0 {
@@ -553,7 +967,7 @@ func mergeWithPlannedConfig(dynamicConfigs, allConfigs []*dataplanev1.Topic_Conf
 		var matched bool
 		for _, cfg := range allConfigs {
 			if cfg != nil && cfg.Name == key {
-				filtered = append(filtered, cfg)
+				filtered = append(filtered, keepPlannedSpelling(cfg, planned))
 				present[key] = true
 				matched = true
 				break
@@ -582,6 +996,45 @@ func mergeWithPlannedConfig(dynamicConfigs, allConfigs []*dataplanev1.Topic_Conf
 	return filtered
 }
 
+// untrackedConfig reports whether an undeclared dynamic config is left out of
+// state.
+//
+// replication.factor is always left out: the broker reports it once it has
+// been changed in place, but it lives in the replication_factor attribute.
+// So is the redpanda.storage.mode = "unset" the broker injects on every
+// topic after v26.1.1; left in state, plan-twice would try to remove the key
+// and the server rejects (the property has no null representation, only
+// local/tiered/cloud/unset). In additive mode every other server-injected
+// `redpanda.*` key is left out too (same shape as tagsFromProto in
+// redpanda/models/cluster/conv.go); authoritative mode reports them so
+// overrides made outside Terraform show up as drift.
+func untrackedConfig(cfg *dataplanev1.Topic_Configuration, authoritative bool) bool {
+	switch {
+	case cfg.Name == replicationFactorConfig:
+		return true
+	case cfg.Name == storageModeConfig && cfg.GetValue() == "unset":
+		return true
+	}
+	return !authoritative && strings.HasPrefix(cfg.Name, "redpanda.")
+}
+
+// keepPlannedSpelling returns cfg carrying the planned value when the broker
+// reports the same value in normalised units (`7d` planned, `604800000`
+// reported), so state keeps what the user wrote instead of drifting.
+func keepPlannedSpelling(cfg *dataplanev1.Topic_Configuration, planned types.Map) *dataplanev1.Topic_Configuration {
+	planString, ok := planned.Elements()[cfg.Name].(types.String)
+	if !ok || planString.IsNull() || planString.IsUnknown() || cfg.Value == nil {
+		return cfg
+	}
+	want := planString.ValueString()
+	if want == cfg.GetValue() || !topicconfig.Equivalent(cfg.Name, want, cfg.GetValue()) {
+		return cfg
+	}
+	out := proto.Clone(cfg).(*dataplanev1.Topic_Configuration)
+	out.Value = &want
+	return out
+}
+
 func isAlreadyExistsError(err error) bool {
 	return strings.Contains(utils.DeserializeGrpcError(err), "TOPIC_ALREADY_EXISTS") || strings.Contains(utils.DeserializeGrpcError(err), "The topic has already been created")
 }
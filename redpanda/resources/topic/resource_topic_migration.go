@@ -0,0 +1,462 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ resource.Resource              = &TopicMigration{}
+	_ resource.ResourceWithConfigure = &TopicMigration{}
+)
+
+const (
+	// defaultTopicMigrationTimeout bounds how long Create waits for the copy
+	// to catch up when timeouts.create is unset.
+	defaultTopicMigrationTimeout = 60 * time.Minute
+
+	defaultTopicMigrationIdleTimeout = "60s"
+)
+
+// secretNamePattern matches the names redpanda_secret accepts.
+var secretNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// TopicMigration represents the topic migration Terraform resource: a new
+// topic created with the partitions and configuration of an existing one and
+// filled with its records by a Redpanda Connect pipeline, since Redpanda
+// cannot rename a topic.
+type TopicMigration struct {
+	base.ResourceBase
+
+	TopicClient    dataplanev1grpc.TopicServiceClient
+	PipelineClient dataplanev1grpc.PipelineServiceClient
+
+	resData config.Resource
+}
+
+// NewTopicMigration constructs a TopicMigration resource.
+func NewTopicMigration() *TopicMigration {
+	r := &TopicMigration{}
+	r.ResourceBase = base.NewResourceBase(
+		"redpanda_topic_migration",
+		ResourceTopicMigrationSchema,
+		func(p config.Resource) { r.resData = p },
+	)
+	return r
+}
+
+// ResourceTopicMigrationSchema returns the schema for the TopicMigration
+// resource.
+func ResourceTopicMigrationSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Copies a topic into a new topic with the same partitions and configuration through a Redpanda Connect pipeline, and waits until the copy has caught up",
+		Attributes: map[string]schema.Attribute{
+			"cluster_api_url": schema.StringAttribute{
+				Description:   "The cluster API URL of the cluster holding both topics.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"source_topic": schema.StringAttribute{
+				Description:   "The name of the existing topic to copy. It is only read.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"target_topic": schema.StringAttribute{
+				Description:   "The name of the topic to create. It must not exist yet. Names must be between 1 and 249 characters and match `^[a-zA-Z0-9._\\-]*$`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 249),
+					stringvalidator.RegexMatches(topicNamePattern, "must match ^[a-zA-Z0-9._\\-]*$"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description:   "The SASL user the pipeline reads and writes the topics as. It needs read access to the source topic and its consumer group, and write access to the target topic.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"password_secret": schema.StringAttribute{
+				Description:   "The name of the `redpanda_secret`, with the `SCOPE_REDPANDA_CONNECT` scope, that holds the password of `username`.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(secretNamePattern, "must match ^[A-Z][A-Z0-9_]*$ (uppercase letters, digits, underscores; must start with a letter)"),
+				},
+			},
+			"sasl_mechanism": schema.StringAttribute{
+				Description:   "The SASL mechanism of `username`. Defaults to `SCRAM-SHA-256`.",
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString("SCRAM-SHA-256"),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{stringvalidator.OneOf("SCRAM-SHA-256", "SCRAM-SHA-512")},
+			},
+			"idle_timeout": schema.StringAttribute{
+				Description:   fmt.Sprintf("How long the source topic must go without new records before the copy counts as caught up and the pipeline stops. Defaults to `%s`.", defaultTopicMigrationIdleTimeout),
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString(defaultTopicMigrationIdleTimeout),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators:    []validator.String{validators.Duration()},
+			},
+			"partition_count": schema.Int32Attribute{
+				Description:   "The number of partitions of the target topic, copied from the source topic.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int32{int32planmodifier.UseStateForUnknown()},
+			},
+			"replication_factor": schema.Int32Attribute{
+				Description:   "The replication factor of the target topic, copied from the source topic.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int32{int32planmodifier.UseStateForUnknown()},
+			},
+			"configuration": schema.MapAttribute{
+				Description:   "The configuration overrides of the source topic that were set on the target topic.",
+				Computed:      true,
+				ElementType:   types.StringType,
+				PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+			},
+			"consumer_group": schema.StringAttribute{
+				Description:   "The consumer group the pipeline reads the source topic with. It is left on the cluster with its committed offsets.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"pipeline_id": schema.StringAttribute{
+				Description:   "The ID of the copy pipeline while it exists. The pipeline is deleted once the copy has caught up.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"completed": schema.BoolAttribute{
+				Description:   "Whether the copy caught up. Destroying a completed migration keeps the target topic; destroying an incomplete one deletes it.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"id": schema.StringAttribute{
+				Description:   "Unique identifier of the resource: the name of the target topic.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create creates the target topic like the source topic, copies the source
+// topic into it with a pipeline, waits for the pipeline to complete and
+// deletes it. State is recorded as soon as the target topic exists, so a
+// failure later on leaves a tainted resource whose destroy cleans up.
+func (r *TopicMigration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan topicmodel.MigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultTopicMigrationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createClients(ctx, plan.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create topic migration clients", utils.DeserializeGrpcError(err))
+		return
+	}
+	source, target := plan.SourceTopic.ValueString(), plan.TargetTopic.ValueString()
+
+	src, err := utils.FindTopicByName(ctx, source, r.TopicClient)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_topic"), fmt.Sprintf("failed to find source topic %q", source), utils.DeserializeGrpcError(err))
+		return
+	}
+	all, err := topicConfigurations(ctx, r.TopicClient, source)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_topic"), fmt.Sprintf("failed to read the configuration of source topic %q", source), utils.DeserializeGrpcError(err))
+		return
+	}
+	spec := migrationSpec(src, all)
+	if err := createTopic(ctx, r.TopicClient, target, spec); err != nil {
+		if isAlreadyExistsError(err) {
+			resp.Diagnostics.AddAttributeError(path.Root("target_topic"), fmt.Sprintf("Failed to create topic; topic %q already exists", target),
+				"The target topic of a migration must not exist yet. Choose another name, or delete the topic if it holds nothing worth keeping.")
+			return
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("target_topic"), fmt.Sprintf("failed to create topic %q", target), utils.DeserializeGrpcError(err))
+		return
+	}
+
+	cfg := make(map[string]attr.Value, len(spec.configuration))
+	for k, v := range spec.configuration {
+		cfg[k] = types.StringValue(v)
+	}
+	plan.ID = plan.TargetTopic
+	plan.PartitionCount = types.Int32Value(*spec.partitionCount)
+	plan.ReplicationFactor = types.Int32Value(*spec.replicationFactor)
+	plan.Configuration = types.MapValueMust(types.StringType, cfg)
+	plan.ConsumerGroup = types.StringValue(migrationConsumerGroup(target))
+	plan.PipelineID = types.StringNull()
+	plan.Completed = types.BoolValue(false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configYaml, err := migrationPipelineConfig(&plan)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to render the copy pipeline configuration", err.Error())
+		return
+	}
+	created, err := r.PipelineClient.CreatePipeline(ctx, &dataplanev1.CreatePipelineRequest{
+		Pipeline: &dataplanev1.PipelineCreate{
+			ConfigYaml:  configYaml,
+			DisplayName: fmt.Sprintf("topic-migration-%s", target),
+			Description: fmt.Sprintf("Copies topic %s into %s for redpanda_topic_migration", source, target),
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create the copy pipeline", utils.DeserializeGrpcError(err))
+		return
+	}
+	pipelineID := created.GetPipeline().GetId()
+	plan.PipelineID = types.StringValue(pipelineID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.PipelineClient.StartPipeline(ctx, &dataplanev1.StartPipelineRequest{Id: pipelineID}); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to start the copy pipeline %s", pipelineID), utils.DeserializeGrpcError(err))
+		return
+	}
+	if err := r.waitForCopy(ctx, pipelineID, createTimeout); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("topic %q did not catch up with %q", target, source),
+			fmt.Sprintf("Copy pipeline %s: %s. Destroying this resource deletes the pipeline and the partial copy in %q.", pipelineID, err, target))
+		return
+	}
+
+	plan.Completed = types.BoolValue(true)
+	if _, err := r.PipelineClient.DeletePipeline(ctx, &dataplanev1.DeletePipelineRequest{Id: pipelineID}); err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("failed to delete the copy pipeline %s", pipelineID),
+			fmt.Sprintf("The copy caught up, but the pipeline was left in place; destroying this resource deletes it: %s", utils.DeserializeGrpcError(err)))
+	} else {
+		plan.PipelineID = types.StringNull()
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read removes the TopicMigration resource from state when the target topic
+// no longer exists, and forgets a copy pipeline that is gone.
+func (r *TopicMigration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state topicmodel.MigrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createClients(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create topic migration clients", utils.DeserializeGrpcError(err))
+		return
+	}
+	if _, err := utils.FindTopicByName(ctx, state.TargetTopic.ValueString(), r.TopicClient); err != nil {
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("target topic %s of a topic migration not found, removing from state", state.TargetTopic.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to read topic %q", state.TargetTopic.ValueString()), utils.DeserializeGrpcError(err))
+		return
+	}
+	if !state.PipelineID.IsNull() {
+		if _, err := r.PipelineClient.GetPipeline(ctx, &dataplanev1.GetPipelineRequest{Id: state.PipelineID.ValueString()}); err != nil {
+			if !utils.IsNotFound(err) {
+				resp.Diagnostics.AddError(fmt.Sprintf("failed to read the copy pipeline %s", state.PipelineID.ValueString()), utils.DeserializeGrpcError(err))
+				return
+			}
+			state.PipelineID = types.StringNull()
+		}
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only records new timeouts; every other attribute forces a
+// replacement.
+func (*TopicMigration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state topicmodel.MigrationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete deletes the copy pipeline if it is still there. The target topic is
+// deleted only when the copy did not catch up; a completed migration leaves
+// it for a redpanda_topic to adopt. The source topic is never touched.
+func (r *TopicMigration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state topicmodel.MigrationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createClients(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create topic migration clients", utils.DeserializeGrpcError(err))
+		return
+	}
+	if !state.PipelineID.IsNull() {
+		pipelineID := state.PipelineID.ValueString()
+		if _, err := r.PipelineClient.DeletePipeline(ctx, &dataplanev1.DeletePipelineRequest{Id: pipelineID}); err != nil && !utils.IsNotFound(err) {
+			resp.Diagnostics.AddError(fmt.Sprintf("failed to delete the copy pipeline %s", pipelineID), utils.DeserializeGrpcError(err))
+			return
+		}
+	}
+	if state.Completed.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("topic migration into %s completed, keeping the topic", state.TargetTopic.ValueString()))
+		return
+	}
+	if err := deleteTopic(ctx, r.TopicClient, state.TargetTopic.ValueString()); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to delete topic %q", state.TargetTopic.ValueString()), utils.DeserializeGrpcError(err))
+	}
+}
+
+func (r *TopicMigration) createClients(ctx context.Context, clusterURL string) error {
+	if r.TopicClient == nil {
+		client, err := utils.NewDataplaneClient(ctx, r.resData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewTopicServiceClient)
+		if err != nil {
+			return err
+		}
+		r.TopicClient = client
+	}
+	if r.PipelineClient == nil {
+		client, err := utils.NewDataplaneClient(ctx, r.resData.DataplaneConnPool, clusterURL, utils.CapabilityAware(clusterURL, r.CpCl, dataplanev1grpc.NewPipelineServiceClient))
+		if err != nil {
+			return err
+		}
+		r.PipelineClient = client
+	}
+	return nil
+}
+
+// waitForCopy polls the pipeline until it completes, which happens once its
+// input has seen no new records for idle_timeout and the output has flushed.
+func (r *TopicMigration) waitForCopy(ctx context.Context, pipelineID string, timeout time.Duration) error {
+	return utils.Retry(ctx, timeout, func() *utils.RetryError {
+		res, err := r.PipelineClient.GetPipeline(ctx, &dataplanev1.GetPipelineRequest{Id: pipelineID})
+		if err != nil {
+			return utils.NonRetryableError(fmt.Errorf("failed to get pipeline state: %w", err))
+		}
+		switch state := res.GetPipeline().GetState(); state {
+		case dataplanev1.Pipeline_STATE_COMPLETED:
+			return nil
+		case dataplanev1.Pipeline_STATE_ERROR:
+			return utils.NonRetryableError(errors.New("pipeline entered error state"))
+		case dataplanev1.Pipeline_STATE_STOPPED:
+			return utils.NonRetryableError(errors.New("pipeline was stopped before the copy caught up"))
+		default:
+			return utils.RetryableError(fmt.Errorf("pipeline in state %s, waiting for it to complete", state))
+		}
+	})
+}
+
+// migrationSpec returns the spec of a target topic matching src: the same
+// partition count and replication factor, and the configuration overrides
+// set on src itself.
+func migrationSpec(src *dataplanev1.ListTopicsResponse_Topic, all []*dataplanev1.Topic_Configuration) topicSpec {
+	pc, rf := src.GetPartitionCount(), src.GetReplicationFactor()
+	cfg := map[string]string{}
+	for _, c := range filterDynamicConfig(all) {
+		cfg[c.GetName()] = c.GetValue()
+	}
+	return topicSpec{partitionCount: &pc, replicationFactor: &rf, configuration: cfg}
+}
+
+func migrationConsumerGroup(target string) string {
+	return "redpanda-topic-migration-" + target
+}
+
+// migrationPipelineConfig renders the Redpanda Connect configuration of the
+// copy pipeline. Each record keeps its key and partition, and one batch is
+// in flight at a time, so the order within every partition is preserved.
+// read_until closes the input after idle_timeout without new records, which
+// completes the pipeline.
+func migrationPipelineConfig(m *topicmodel.MigrationModel) (string, error) {
+	sasl := []map[string]any{{
+		"mechanism": m.SASLMechanism.ValueString(),
+		"username":  m.Username.ValueString(),
+		"password":  fmt.Sprintf("${secrets.%s}", m.PasswordSecret.ValueString()),
+	}}
+	cfg := map[string]any{
+		"input": map[string]any{
+			"read_until": map[string]any{
+				"idle_timeout": m.IdleTimeout.ValueString(),
+				"input": map[string]any{
+					"kafka_franz": map[string]any{
+						"seed_brokers":      []string{"${REDPANDA_BROKERS}"},
+						"topics":            []string{m.SourceTopic.ValueString()},
+						"consumer_group":    m.ConsumerGroup.ValueString(),
+						"start_from_oldest": true,
+						"tls":               map[string]any{"enabled": true},
+						"sasl":              sasl,
+					},
+				},
+			},
+		},
+		"output": map[string]any{
+			"kafka_franz": map[string]any{
+				"seed_brokers":  []string{"${REDPANDA_BROKERS}"},
+				"topic":         m.TargetTopic.ValueString(),
+				"key":           "${! @kafka_key }",
+				"partitioner":   "manual",
+				"partition":     "${! @kafka_partition }",
+				"max_in_flight": 1,
+				"tls":           map[string]any{"enabled": true},
+				"sasl":          sasl,
+			},
+		},
+	}
+	out, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
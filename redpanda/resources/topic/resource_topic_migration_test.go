@@ -0,0 +1,245 @@
+package topic
+
+import (
+	"context"
+	"testing"
+
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v3"
+)
+
+func migrationModel() topicmodel.MigrationModel {
+	return topicmodel.MigrationModel{
+		ClusterAPIURL:     types.StringValue(testReplicaAPIURL),
+		Completed:         types.BoolUnknown(),
+		Configuration:     types.MapUnknown(types.StringType),
+		ConsumerGroup:     types.StringUnknown(),
+		ID:                types.StringUnknown(),
+		IdleTimeout:       types.StringValue(defaultTopicMigrationIdleTimeout),
+		PartitionCount:    types.Int32Unknown(),
+		PasswordSecret:    types.StringValue("MIGRATION_PASSWORD"),
+		PipelineID:        types.StringUnknown(),
+		ReplicationFactor: types.Int32Unknown(),
+		SASLMechanism:     types.StringValue("SCRAM-SHA-256"),
+		SourceTopic:       types.StringValue("orders"),
+		TargetTopic:       types.StringValue("team-a.orders"),
+		Timeouts: timeouts.Value{Object: types.ObjectValueMust(
+			map[string]attr.Type{"create": types.StringType},
+			map[string]attr.Value{"create": types.StringNull()},
+		)},
+		Username: types.StringValue("migrator"),
+	}
+}
+
+// expectSourceTopic sets up the reads of the source topic and the creation
+// of the target topic like it.
+func expectSourceTopic(t *testing.T, topics *mocks.MockTopicServiceClient) {
+	t.Helper()
+	topics.EXPECT().
+		ListTopics(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.ListTopicsResponse{Topics: []*dataplanev1.ListTopicsResponse_Topic{
+			{Name: "orders", PartitionCount: 6, ReplicationFactor: 3},
+		}}, nil)
+	topics.EXPECT().
+		GetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.GetTopicConfigurationsResponse{Configurations: []*dataplanev1.Topic_Configuration{
+			{Name: "cleanup.policy", Value: strPtr("compact"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+			{Name: "retention.ms", Value: strPtr("604800000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+		}}, nil)
+	topics.EXPECT().
+		CreateTopic(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.CreateTopicRequest, _ ...grpc.CallOption) (*dataplanev1.CreateTopicResponse, error) {
+			assert.Equal(t, "team-a.orders", req.GetTopic().GetName())
+			assert.Equal(t, int32(6), req.GetTopic().GetPartitionCount())
+			assert.Equal(t, int32(3), req.GetTopic().GetReplicationFactor())
+			if assert.Len(t, req.GetTopic().GetConfigs(), 1, "only overrides set on the source topic are copied") {
+				assert.Equal(t, "cleanup.policy", req.GetTopic().GetConfigs()[0].GetName())
+			}
+			return &dataplanev1.CreateTopicResponse{TopicName: req.GetTopic().GetName()}, nil
+		})
+}
+
+func createMigration(t *testing.T, r *TopicMigration) (topicmodel.MigrationModel, resource.CreateResponse) {
+	t.Helper()
+	ctx := context.Background()
+	s := ResourceTopicMigrationSchema(ctx)
+	plan := migrationModel()
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	r.Create(ctx, req, &resp)
+	var state topicmodel.MigrationModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	return state, resp
+}
+
+func TestUnit_TopicMigration_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	topics := mocks.NewMockTopicServiceClient(ctrl)
+	pipelines := mocks.NewMockPipelineServiceClient(ctrl)
+	expectSourceTopic(t, topics)
+	gomock.InOrder(
+		pipelines.EXPECT().
+			CreatePipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *dataplanev1.CreatePipelineRequest, _ ...grpc.CallOption) (*dataplanev1.CreatePipelineResponse, error) {
+				assert.Contains(t, req.GetPipeline().GetConfigYaml(), "read_until")
+				return &dataplanev1.CreatePipelineResponse{Pipeline: &dataplanev1.Pipeline{Id: "copy-1"}}, nil
+			}),
+		pipelines.EXPECT().
+			StartPipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&dataplanev1.StartPipelineResponse{}, nil),
+		pipelines.EXPECT().
+			GetPipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&dataplanev1.GetPipelineResponse{Pipeline: &dataplanev1.Pipeline{Id: "copy-1", State: dataplanev1.Pipeline_STATE_COMPLETED}}, nil),
+		pipelines.EXPECT().
+			DeletePipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *dataplanev1.DeletePipelineRequest, _ ...grpc.CallOption) (*dataplanev1.DeletePipelineResponse, error) {
+				assert.Equal(t, "copy-1", req.GetId())
+				return &dataplanev1.DeletePipelineResponse{}, nil
+			}),
+	)
+
+	state, resp := createMigration(t, &TopicMigration{TopicClient: topics, PipelineClient: pipelines})
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.True(t, state.Completed.ValueBool())
+	assert.True(t, state.PipelineID.IsNull(), "the pipeline is deleted once the copy caught up")
+	assert.Equal(t, int32(6), state.PartitionCount.ValueInt32())
+	assert.Equal(t, "team-a.orders", state.ID.ValueString())
+	assert.Equal(t, "redpanda-topic-migration-team-a.orders", state.ConsumerGroup.ValueString())
+	assert.Equal(t, map[string]attr.Value{"cleanup.policy": types.StringValue("compact")}, state.Configuration.Elements())
+}
+
+func TestUnit_TopicMigration_Create_PipelineError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	topics := mocks.NewMockTopicServiceClient(ctrl)
+	pipelines := mocks.NewMockPipelineServiceClient(ctrl)
+	expectSourceTopic(t, topics)
+	pipelines.EXPECT().
+		CreatePipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.CreatePipelineResponse{Pipeline: &dataplanev1.Pipeline{Id: "copy-1"}}, nil)
+	pipelines.EXPECT().
+		StartPipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.StartPipelineResponse{}, nil)
+	pipelines.EXPECT().
+		GetPipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.GetPipelineResponse{Pipeline: &dataplanev1.Pipeline{Id: "copy-1", State: dataplanev1.Pipeline_STATE_ERROR}}, nil)
+
+	state, resp := createMigration(t, &TopicMigration{TopicClient: topics, PipelineClient: pipelines})
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	assert.False(t, state.Completed.ValueBool())
+	assert.Equal(t, "copy-1", state.PipelineID.ValueString(), "the pipeline stays in state so destroy removes it")
+}
+
+func TestUnit_TopicMigration_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		completed   bool
+		pipelineID  types.String
+		deleteTopic bool
+	}{
+		{name: "incomplete copy is removed with its pipeline", completed: false, pipelineID: types.StringValue("copy-1"), deleteTopic: true},
+		{name: "completed copy keeps the target topic", completed: true, pipelineID: types.StringNull(), deleteTopic: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctrl := gomock.NewController(t)
+			topics := mocks.NewMockTopicServiceClient(ctrl)
+			pipelines := mocks.NewMockPipelineServiceClient(ctrl)
+			if !tt.pipelineID.IsNull() {
+				pipelines.EXPECT().
+					DeletePipeline(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *dataplanev1.DeletePipelineRequest, _ ...grpc.CallOption) (*dataplanev1.DeletePipelineResponse, error) {
+						assert.Equal(t, tt.pipelineID.ValueString(), req.GetId())
+						return &dataplanev1.DeletePipelineResponse{}, nil
+					})
+			}
+			if tt.deleteTopic {
+				topics.EXPECT().
+					DeleteTopic(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *dataplanev1.DeleteTopicRequest, _ ...grpc.CallOption) (*dataplanev1.DeleteTopicResponse, error) {
+						assert.Equal(t, "team-a.orders", req.GetTopicName())
+						return &dataplanev1.DeleteTopicResponse{}, nil
+					})
+			}
+
+			s := ResourceTopicMigrationSchema(ctx)
+			m := migrationModel()
+			m.ID = m.TargetTopic
+			m.Completed = types.BoolValue(tt.completed)
+			m.Configuration = types.MapNull(types.StringType)
+			m.ConsumerGroup = types.StringValue(migrationConsumerGroup("team-a.orders"))
+			m.PartitionCount = types.Int32Value(6)
+			m.PipelineID = tt.pipelineID
+			m.ReplicationFactor = types.Int32Value(3)
+			req := resource.DeleteRequest{State: tfsdk.State{Schema: s}}
+			require.False(t, req.State.Set(ctx, &m).HasError())
+			resp := resource.DeleteResponse{State: tfsdk.State{Schema: s}}
+
+			(&TopicMigration{TopicClient: topics, PipelineClient: pipelines}).Delete(ctx, req, &resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
+
+func TestUnit_MigrationPipelineConfig(t *testing.T) {
+	m := migrationModel()
+	m.ConsumerGroup = types.StringValue(migrationConsumerGroup("team-a.orders"))
+	out, err := migrationPipelineConfig(&m)
+	require.NoError(t, err)
+
+	var cfg struct {
+		Input struct {
+			ReadUntil struct {
+				IdleTimeout string `yaml:"idle_timeout"`
+				Input       struct {
+					KafkaFranz struct {
+						Topics          []string            `yaml:"topics"`
+						ConsumerGroup   string              `yaml:"consumer_group"`
+						StartFromOldest bool                `yaml:"start_from_oldest"`
+						SASL            []map[string]string `yaml:"sasl"`
+					} `yaml:"kafka_franz"`
+				} `yaml:"input"`
+			} `yaml:"read_until"`
+		} `yaml:"input"`
+		Output struct {
+			KafkaFranz struct {
+				Topic       string `yaml:"topic"`
+				Key         string `yaml:"key"`
+				Partitioner string `yaml:"partitioner"`
+				Partition   string `yaml:"partition"`
+				MaxInFlight int    `yaml:"max_in_flight"`
+			} `yaml:"kafka_franz"`
+		} `yaml:"output"`
+	}
+	require.NoError(t, yaml.Unmarshal([]byte(out), &cfg), out)
+
+	in := cfg.Input.ReadUntil
+	assert.Equal(t, "60s", in.IdleTimeout)
+	assert.Equal(t, []string{"orders"}, in.Input.KafkaFranz.Topics)
+	assert.Equal(t, "redpanda-topic-migration-team-a.orders", in.Input.KafkaFranz.ConsumerGroup)
+	assert.True(t, in.Input.KafkaFranz.StartFromOldest)
+	assert.Equal(t, []map[string]string{{
+		"mechanism": "SCRAM-SHA-256",
+		"username":  "migrator",
+		"password":  "${secrets.MIGRATION_PASSWORD}",
+	}}, in.Input.KafkaFranz.SASL)
+
+	o := cfg.Output.KafkaFranz
+	assert.Equal(t, "team-a.orders", o.Topic)
+	assert.Equal(t, "${! @kafka_key }", o.Key)
+	assert.Equal(t, "manual", o.Partitioner)
+	assert.Equal(t, "${! @kafka_partition }", o.Partition)
+	assert.Equal(t, 1, o.MaxInFlight, "one batch in flight keeps the order within each partition")
+}
@@ -0,0 +1,825 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/base"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/config"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/topicconfig"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+	"golang.org/x/sync/errgroup"
+)
+
+var (
+	_ resource.Resource                = &TopicSet{}
+	_ resource.ResourceWithConfigure   = &TopicSet{}
+	_ resource.ResourceWithImportState = &TopicSet{}
+	_ resource.ResourceWithModifyPlan  = &TopicSet{}
+)
+
+// defaultTopicSetParallelism is how many topics a topic set creates, alters,
+// deletes or reads at once when parallelism is unset.
+const defaultTopicSetParallelism = 10
+
+var topicNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._\-]*$`)
+
+// TopicSet represents the topic set Terraform resource: many topics of one
+// cluster managed together, so refresh and apply cost a handful of calls per
+// topic instead of a resource each.
+type TopicSet struct {
+	base.ResourceBase
+
+	TopicClient dataplanev1grpc.TopicServiceClient
+
+	resData config.Resource
+}
+
+// NewTopicSet constructs a TopicSet resource.
+func NewTopicSet() *TopicSet {
+	r := &TopicSet{}
+	r.ResourceBase = base.NewResourceBase(
+		"redpanda_topic_set",
+		ResourceTopicSetSchema,
+		func(p config.Resource) { r.resData = p },
+	)
+	return r
+}
+
+// ResourceTopicSetSchema returns the schema for the TopicSet resource.
+func ResourceTopicSetSchema(_ context.Context) schema.Schema {
+	return schema.Schema{
+		Description: "Manages many topics of one cluster as a single resource, with shared defaults and per-topic overrides",
+		Attributes: map[string]schema.Attribute{
+			"cluster_api_url": schema.StringAttribute{
+				Description:   "The cluster API URL of the cluster the topics are created on.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"defaults": schema.SingleNestedAttribute{
+				Description: "Settings applied to every topic in `topics` that does not set them itself. Configuration keys are merged, with the topic's own value taking precedence.",
+				Optional:    true,
+				Attributes:  topicSetTopicAttributes("every topic"),
+			},
+			"topics": schema.MapNestedAttribute{
+				Description: "The topics of the set, keyed by topic name. Removing a topic deletes it, which requires `allow_deletion`. Names must be between 1 and 249 characters and match `^[a-zA-Z0-9._\\-]*$`.",
+				Required:    true,
+				Validators: []validator.Map{mapvalidator.KeysAre(
+					stringvalidator.LengthBetween(1, 249),
+					stringvalidator.RegexMatches(topicNamePattern, "must match ^[a-zA-Z0-9._\\-]*$"),
+				)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: topicSetTopicAttributes("the topic"),
+				},
+			},
+			"parallelism": schema.Int32Attribute{
+				Description: fmt.Sprintf("How many topics are created, altered, deleted or refreshed at once. Defaults to %d.", defaultTopicSetParallelism),
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(defaultTopicSetParallelism),
+				Validators:  []validator.Int32{int32validator.Between(1, 100)},
+			},
+			"allow_deletion": schema.BoolAttribute{
+				Description: "Whether Terraform may delete topics of the set, either by removing them from `topics` or by destroying this resource. Defaults to false. After `terraform import`, defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Description:   "Unique identifier of the resource.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// topicSetTopicAttributes returns the attributes shared by "defaults" and
+// each entry of "topics"; subject names what they apply to.
+func topicSetTopicAttributes(subject string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"partition_count": schema.Int32Attribute{
+			Description: fmt.Sprintf("The number of partitions of %s. Increases are applied in place; decreases are rejected at plan time.", subject),
+			Optional:    true,
+			Validators:  []validator.Int32{int32validator.AtLeast(1)},
+		},
+		"replication_factor": schema.Int32Attribute{
+			Description: fmt.Sprintf("The number of replicas of every partition of %s. Changes are applied in place; Redpanda moves the replicas in the background after the apply.", subject),
+			Optional:    true,
+			Validators:  []validator.Int32{int32validator.Between(1, 5)},
+		},
+		"configuration": schema.MapAttribute{
+			Description: fmt.Sprintf("A map of string key/value pairs of topic configurations for %s, checked at plan time against the provider's catalog of Redpanda topic properties.", subject),
+			Optional:    true,
+			ElementType: types.StringType,
+			Validators:  []validator.Map{validators.TopicConfiguration()},
+		},
+	}
+}
+
+// topicSpec holds what one topic of a set should look like: its entry in
+// topics with the set defaults filled in. Configuration values are
+// normalised so that equivalent spellings compare equal.
+type topicSpec struct {
+	partitionCount    *int32
+	replicationFactor *int32
+	configuration     map[string]string
+}
+
+// ModifyPlan rejects plans that remove topics while allow_deletion is false,
+// and plans that decrease a topic's partition count. A topic set cannot
+// replace one of its topics, so both would otherwise fail or destroy data at
+// apply.
+func (*TopicSet) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	var plan, state topicmodel.SetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Topics.IsUnknown() || plan.Defaults.IsUnknown() {
+		return
+	}
+	planned, plannedDefaults, diags := setTopics(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	prior, priorDefaults, diags := setTopics(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(prior)) {
+		to, ok := planned[name]
+		if !ok {
+			if !plan.AllowDeletion.ValueBool() {
+				resp.Diagnostics.AddAttributeError(path.Root("topics").AtMapKey(name), "Topic deletion not allowed",
+					fmt.Sprintf("Removing %q from topics deletes the topic; set allow_deletion = true to allow it.", name))
+			}
+			continue
+		}
+		want := effectiveCount(plannedDefaults.PartitionCount, to.PartitionCount)
+		have := effectiveCount(priorDefaults.PartitionCount, prior[name].PartitionCount)
+		if want.IsNull() || want.IsUnknown() || have.IsNull() || have.IsUnknown() {
+			continue
+		}
+		if want.ValueInt32() < have.ValueInt32() {
+			resp.Diagnostics.AddAttributeError(path.Root("topics").AtMapKey(name).AtName("partition_count"), "Partition count cannot be decreased",
+				fmt.Sprintf("Topic %q has %d partitions and Kafka cannot remove partitions. To recreate the topic with fewer partitions, remove it from topics, apply, then add it back.", name, have.ValueInt32()))
+		}
+	}
+}
+
+// Create creates every topic of the TopicSet resource. Topics that fail to
+// be created are reported and left out of state; the ones that were created
+// are recorded so they are not orphaned.
+func (r *TopicSet) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan topicmodel.SetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	topics, defaults, diags := setTopics(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	specs, diags := topicSpecs(defaults, topics)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createTopicClient(ctx, plan.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create topic client", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	names := slices.Sorted(maps.Keys(specs))
+	errs := forEachTopic(ctx, names, int(plan.Parallelism.ValueInt32()), func(ctx context.Context, name string) error {
+		return createTopic(ctx, r.TopicClient, name, specs[name])
+	})
+	for _, name := range names {
+		if err, failed := errs[name]; failed {
+			delete(topics, name)
+			resp.Diagnostics.Append(topicSetCreateError(name, err))
+		}
+	}
+	if len(errs) > 0 && len(errs) == len(names) {
+		return
+	}
+
+	plan.ID = plan.ClusterAPIURL
+	plan.Topics, diags = setTopicsValue(topics)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes every topic of the TopicSet resource from one listing of
+// the cluster's topics and a configuration read per topic. Topics that no
+// longer exist are dropped from state, so the next apply creates them again.
+func (r *TopicSet) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state topicmodel.SetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.ClusterAPIURL.IsNull() || state.ClusterAPIURL.IsUnknown() || state.ClusterAPIURL.ValueString() == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err := r.createTopicClient(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		action, diags := utils.HandleGracefulRemoval(ctx, "topic set", state.ID.ValueString(), state.AllowDeletion, err, "create topic client")
+		resp.Diagnostics.Append(diags...)
+		if action == utils.RemoveFromState {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+	topics, defaults, diags := setTopics(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	listed, err := r.listTopics(ctx)
+	if err != nil {
+		action, diags := utils.HandleGracefulRemoval(ctx, "topic set", state.ID.ValueString(), state.AllowDeletion, err, "list topics")
+		resp.Diagnostics.Append(diags...)
+		if action == utils.RemoveFromState {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+	var names []string
+	for _, name := range slices.Sorted(maps.Keys(topics)) {
+		if _, ok := listed[name]; ok {
+			names = append(names, name)
+		} else {
+			delete(topics, name)
+		}
+	}
+
+	var mu sync.Mutex
+	configs := make(map[string][]*dataplanev1.Topic_Configuration, len(names))
+	errs := forEachTopic(ctx, names, int(state.Parallelism.ValueInt32()), func(ctx context.Context, name string) error {
+		cfgs, err := topicConfigurations(ctx, r.TopicClient, name)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		configs[name] = cfgs
+		return nil
+	})
+	for _, name := range names {
+		if err, failed := errs[name]; failed {
+			resp.Diagnostics.AddAttributeError(path.Root("topics").AtMapKey(name), fmt.Sprintf("failed to retrieve %q topic configuration", name), utils.DeserializeGrpcError(err))
+			continue
+		}
+		entry, diags := refreshSetTopic(defaults, topics[name], listed[name], configs[name])
+		resp.Diagnostics.Append(diags...)
+		topics[name] = entry
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Topics, diags = setTopicsValue(topics)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update creates the topics added to the set, deletes the ones removed from
+// it and alters the ones whose settings changed, either directly or through
+// defaults. A topic whose change fails keeps its prior settings in state so
+// the next plan retries it.
+func (r *TopicSet) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state topicmodel.SetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	planned, plannedDefaults, diags := setTopics(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	prior, priorDefaults, diags := setTopics(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	to, diags := topicSpecs(plannedDefaults, planned)
+	resp.Diagnostics.Append(diags...)
+	from, diags := topicSpecs(priorDefaults, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.createTopicClient(ctx, plan.ClusterAPIURL.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to create topic client", utils.DeserializeGrpcError(err))
+		return
+	}
+
+	names := slices.Sorted(maps.Keys(to))
+	for name := range from {
+		if _, ok := to[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	errs := forEachTopic(ctx, names, int(plan.Parallelism.ValueInt32()), func(ctx context.Context, name string) error {
+		want, inPlan := to[name]
+		have, inState := from[name]
+		switch {
+		case !inState:
+			return createTopic(ctx, r.TopicClient, name, want)
+		case !inPlan:
+			return deleteTopic(ctx, r.TopicClient, name)
+		default:
+			return r.updateTopic(ctx, name, have, want)
+		}
+	})
+	for _, name := range names {
+		err, failed := errs[name]
+		if !failed {
+			continue
+		}
+		have, inState := from[name]
+		_, inPlan := to[name]
+		switch {
+		case !inState:
+			delete(planned, name)
+			resp.Diagnostics.Append(topicSetCreateError(name, err))
+			continue
+		case !inPlan:
+			resp.Diagnostics.AddAttributeError(path.Root("topics").AtMapKey(name), fmt.Sprintf("failed to delete topic %q", name), utils.DeserializeGrpcError(err))
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("topics").AtMapKey(name), fmt.Sprintf("failed to update topic %q", name), utils.DeserializeGrpcError(err))
+		}
+		entry, diags := have.model()
+		resp.Diagnostics.Append(diags...)
+		planned[name] = entry
+	}
+
+	plan.Topics, diags = setTopicsValue(planned)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes every topic of the TopicSet resource.
+func (r *TopicSet) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state topicmodel.SetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !state.AllowDeletion.IsNull() && !state.AllowDeletion.ValueBool() {
+		resp.Diagnostics.AddError(fmt.Sprintf("topic set %s does not allow deletion", state.ID.ValueString()), "allow_deletion is set to false")
+		return
+	}
+	if err := r.createTopicClient(ctx, state.ClusterAPIURL.ValueString()); err != nil {
+		_, diags := utils.HandleGracefulRemoval(ctx, "topic set", state.ID.ValueString(), state.AllowDeletion, err, "create topic client")
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	topics, _, diags := setTopics(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := slices.Sorted(maps.Keys(topics))
+	errs := forEachTopic(ctx, names, int(state.Parallelism.ValueInt32()), func(ctx context.Context, name string) error {
+		return deleteTopic(ctx, r.TopicClient, name)
+	})
+	for _, name := range names {
+		if err, failed := errs[name]; failed {
+			resp.Diagnostics.AddAttributeError(path.Root("topics").AtMapKey(name), fmt.Sprintf("failed to delete topic %q", name), utils.DeserializeGrpcError(err))
+		}
+	}
+}
+
+// ImportState imports a TopicSet via "<cluster_id>,<topic_name_prefix>",
+// adopting every topic of the cluster whose name starts with the prefix.
+// Their partition count and replication factor are recorded on each topic;
+// the next refresh records their configuration overrides.
+func (r *TopicSet) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterID, prefix, ok := utils.SplitImportID(req.ID, ",")
+	if !ok || prefix == "" {
+		resp.Diagnostics.AddError(fmt.Sprintf("wrong ID format: %v", req.ID), "ID format is <cluster_id>,<topic_name_prefix>")
+		return
+	}
+	dataplaneURL, err := r.CpCl.DataplaneURLForCluster(ctx, clusterID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("failed to resolve dataplane URL for cluster %q; make sure ID format is <cluster_id>,<topic_name_prefix>", clusterID),
+			err.Error(),
+		)
+		return
+	}
+	if err := r.createTopicClient(ctx, dataplaneURL); err != nil {
+		resp.Diagnostics.AddError("failed to create topic client", utils.DeserializeGrpcError(err))
+		return
+	}
+	listed, err := r.listTopics(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("failed to list topics of cluster %q", clusterID), utils.DeserializeGrpcError(err))
+		return
+	}
+	topics := map[string]topicmodel.SetTopicModel{}
+	for name, tp := range listed {
+		if strings.HasPrefix(name, prefix) {
+			topics[name] = topicmodel.SetTopicModel{
+				Configuration:     types.MapNull(types.StringType),
+				PartitionCount:    types.Int32Value(tp.GetPartitionCount()),
+				ReplicationFactor: types.Int32Value(tp.GetReplicationFactor()),
+			}
+		}
+	}
+	if len(topics) == 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("no topics of cluster %q start with %q", clusterID, prefix), "Check the topic name prefix of the import ID.")
+		return
+	}
+	topicsValue, diags := setTopicsValue(topics)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(dataplaneURL))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_api_url"), types.StringValue(dataplaneURL))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("topics"), topicsValue)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("defaults"), types.ObjectNull(topicmodel.SetTopicAttrTypes()))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parallelism"), types.Int32Value(defaultTopicSetParallelism))...)
+	resp.Diagnostics.Append(utils.ImportStateBoolFromSchemaDefault(ctx, ResourceTopicSetSchema(ctx), &resp.State, "allow_deletion")...)
+}
+
+func (r *TopicSet) createTopicClient(ctx context.Context, clusterURL string) error {
+	if r.TopicClient != nil {
+		return nil
+	}
+	client, err := utils.NewDataplaneClient(ctx, r.resData.DataplaneConnPool, clusterURL, dataplanev1grpc.NewTopicServiceClient)
+	if err != nil {
+		return err
+	}
+	r.TopicClient = client
+	return nil
+}
+
+// listTopics returns every topic of the cluster by name, paging through a
+// single unfiltered ListTopics.
+func (r *TopicSet) listTopics(ctx context.Context) (map[string]*dataplanev1.ListTopicsResponse_Topic, error) {
+	out := map[string]*dataplanev1.ListTopicsResponse_Topic{}
+	var pageToken string
+	for {
+		var res *dataplanev1.ListTopicsResponse
+		err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+			var listErr error
+			res, listErr = r.TopicClient.ListTopics(ctx, &dataplanev1.ListTopicsRequest{PageToken: pageToken})
+			if listErr != nil {
+				if isTransientBrokerError(listErr) {
+					return utils.RetryableError(listErr)
+				}
+				return utils.NonRetryableError(listErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, tp := range res.GetTopics() {
+			out[tp.GetName()] = tp
+		}
+		pageToken = res.GetNextPageToken()
+		if pageToken == "" {
+			return out, nil
+		}
+	}
+}
+
+// topicConfigurations returns every configuration the broker reports for
+// the topic.
+func topicConfigurations(ctx context.Context, client dataplanev1grpc.TopicServiceClient, name string) ([]*dataplanev1.Topic_Configuration, error) {
+	var res *dataplanev1.GetTopicConfigurationsResponse
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		var cfgErr error
+		res, cfgErr = client.GetTopicConfigurations(ctx, &dataplanev1.GetTopicConfigurationsRequest{TopicName: name})
+		if cfgErr != nil {
+			if isTransientBrokerError(cfgErr) {
+				return utils.RetryableError(cfgErr)
+			}
+			return utils.NonRetryableError(cfgErr)
+		}
+		return nil
+	})
+	return res.GetConfigurations(), err
+}
+
+func createTopic(ctx context.Context, client dataplanev1grpc.TopicServiceClient, name string, spec topicSpec) error {
+	req := spec.createRequest(name)
+	return utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		if _, createErr := client.CreateTopic(ctx, req); createErr != nil {
+			if isAlreadyExistsError(createErr) {
+				return utils.NonRetryableError(createErr)
+			}
+			if isTransientBrokerError(createErr) {
+				if _, findErr := utils.FindTopicByName(ctx, name, client); findErr == nil {
+					return nil
+				}
+				return utils.RetryableError(fmt.Errorf("transient broker error, retrying: %w", createErr))
+			}
+			return utils.NonRetryableError(createErr)
+		}
+		return nil
+	})
+}
+
+// updateTopic brings a topic from have to want: it rewrites the topic
+// configuration when it changed, adds partitions and moves replicas.
+func (r *TopicSet) updateTopic(ctx context.Context, name string, have, want topicSpec) error {
+	if !maps.Equal(have.configuration, want.configuration) {
+		cfgs := make([]*dataplanev1.SetTopicConfigurationsRequest_SetConfiguration, 0, len(want.configuration))
+		for _, k := range slices.Sorted(maps.Keys(want.configuration)) {
+			v := want.configuration[k]
+			cfgs = append(cfgs, &dataplanev1.SetTopicConfigurationsRequest_SetConfiguration{Name: k, Value: &v})
+		}
+		err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+			_, setErr := r.TopicClient.SetTopicConfigurations(ctx, &dataplanev1.SetTopicConfigurationsRequest{
+				TopicName:      name,
+				Configurations: cfgs,
+			})
+			if setErr != nil {
+				if isTransientBrokerError(setErr) {
+					return utils.RetryableError(setErr)
+				}
+				return utils.NonRetryableError(setErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update topic configuration: %w", err)
+		}
+	}
+	if want.partitionCount != nil && (have.partitionCount == nil || *want.partitionCount > *have.partitionCount) {
+		err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+			_, setErr := r.TopicClient.SetTopicPartitions(ctx, &dataplanev1.SetTopicPartitionsRequest{
+				TopicName:      name,
+				PartitionCount: *want.partitionCount,
+			})
+			if setErr != nil {
+				if isTransientBrokerError(setErr) {
+					return utils.RetryableError(setErr)
+				}
+				return utils.NonRetryableError(setErr)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update partition count: %w", err)
+		}
+	}
+	if want.replicationFactor != nil && (have.replicationFactor == nil || *want.replicationFactor != *have.replicationFactor) {
+		if err := setReplicationFactor(ctx, r.TopicClient, name, *want.replicationFactor); err != nil {
+			return fmt.Errorf("failed to update replication factor: %w", err)
+		}
+	}
+	return nil
+}
+
+func deleteTopic(ctx context.Context, client dataplanev1grpc.TopicServiceClient, name string) error {
+	return utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		if _, delErr := client.DeleteTopic(ctx, &dataplanev1.DeleteTopicRequest{TopicName: name}); delErr != nil {
+			if isNotFoundError(delErr) {
+				return nil
+			}
+			if isTransientBrokerError(delErr) {
+				return utils.RetryableError(delErr)
+			}
+			return utils.NonRetryableError(delErr)
+		}
+		return nil
+	})
+}
+
+// forEachTopic calls fn for every name, with at most limit calls in flight,
+// and returns the error of each failed call by topic name. A failed call
+// does not cancel the others, so one bad topic does not leave the rest of
+// the set half applied.
+func forEachTopic(ctx context.Context, names []string, limit int, fn func(ctx context.Context, name string) error) map[string]error {
+	var (
+		g    errgroup.Group
+		mu   sync.Mutex
+		errs = map[string]error{}
+	)
+	g.SetLimit(max(limit, 1))
+	for _, name := range names {
+		g.Go(func() error {
+			if err := fn(ctx, name); err != nil {
+				mu.Lock()
+				defer mu.Unlock()
+				errs[name] = err
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return errs
+}
+
+func topicSetCreateError(name string, err error) diag.Diagnostic {
+	if isAlreadyExistsError(err) {
+		return diag.NewAttributeErrorDiagnostic(path.Root("topics").AtMapKey(name),
+			fmt.Sprintf("Failed to create topic; topic %q already exists", name),
+			"Existing topics can be adopted by importing the set using 'terraform import redpanda_topic_set.<resource_name> <cluster_id>,<topic_name_prefix>'",
+		)
+	}
+	return diag.NewAttributeErrorDiagnostic(path.Root("topics").AtMapKey(name), fmt.Sprintf("failed to create topic %q", name), utils.DeserializeGrpcError(err))
+}
+
+// setTopics returns the entries of topics by name, and the defaults, which
+// are all null when unset.
+func setTopics(ctx context.Context, m *topicmodel.SetModel) (map[string]topicmodel.SetTopicModel, topicmodel.SetTopicModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	topics := map[string]topicmodel.SetTopicModel{}
+	if !m.Topics.IsNull() && !m.Topics.IsUnknown() {
+		diags.Append(m.Topics.ElementsAs(ctx, &topics, false)...)
+	}
+	defaults := topicmodel.SetTopicModel{
+		Configuration:     types.MapNull(types.StringType),
+		PartitionCount:    types.Int32Null(),
+		ReplicationFactor: types.Int32Null(),
+	}
+	if !m.Defaults.IsNull() && !m.Defaults.IsUnknown() {
+		diags.Append(m.Defaults.As(ctx, &defaults, basetypes.ObjectAsOptions{})...)
+	}
+	return topics, defaults, diags
+}
+
+// setTopicsValue returns the value of the topics attribute for entries.
+func setTopicsValue(entries map[string]topicmodel.SetTopicModel) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	elemType := types.ObjectType{AttrTypes: topicmodel.SetTopicAttrTypes()}
+	elems := make(map[string]attr.Value, len(entries))
+	for name, e := range entries {
+		obj, d := types.ObjectValue(topicmodel.SetTopicAttrTypes(), map[string]attr.Value{
+			"configuration":      e.Configuration,
+			"partition_count":    e.PartitionCount,
+			"replication_factor": e.ReplicationFactor,
+		})
+		diags.Append(d...)
+		elems[name] = obj
+	}
+	m, d := types.MapValue(elemType, elems)
+	diags.Append(d...)
+	return m, diags
+}
+
+// topicSpecs returns the spec of every entry with defaults filled in.
+func topicSpecs(defaults topicmodel.SetTopicModel, entries map[string]topicmodel.SetTopicModel) (map[string]topicSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	specs := make(map[string]topicSpec, len(entries))
+	for name, e := range entries {
+		cfg, err := configurationValues(mergedConfiguration(defaults.Configuration, e.Configuration))
+		if err != nil {
+			diags.AddAttributeError(path.Root("topics").AtMapKey(name).AtName("configuration"), fmt.Sprintf("failed to parse topic configuration for %s", name), err.Error())
+			continue
+		}
+		specs[name] = topicSpec{
+			partitionCount:    effectiveCount(defaults.PartitionCount, e.PartitionCount).ValueInt32Pointer(),
+			replicationFactor: effectiveCount(defaults.ReplicationFactor, e.ReplicationFactor).ValueInt32Pointer(),
+			configuration:     cfg,
+		}
+	}
+	return specs, diags
+}
+
+// effectiveCount returns the topic's own value, or the default when the
+// topic does not set one.
+func effectiveCount(def, own types.Int32) types.Int32 {
+	if own.IsNull() {
+		return def
+	}
+	return own
+}
+
+// mergedConfiguration returns the default configuration overlaid with the
+// topic's own keys, keeping the spelling each was written in.
+func mergedConfiguration(defaults, own types.Map) types.Map {
+	if defaults.IsNull() || defaults.IsUnknown() {
+		return own
+	}
+	elems := maps.Clone(defaults.Elements())
+	maps.Copy(elems, own.Elements())
+	return types.MapValueMust(types.StringType, elems)
+}
+
+func (s topicSpec) createRequest(name string) *dataplanev1.CreateTopicRequest {
+	cfgs := make([]*dataplanev1.CreateTopicRequest_Topic_Config, 0, len(s.configuration))
+	for _, k := range slices.Sorted(maps.Keys(s.configuration)) {
+		v := s.configuration[k]
+		cfgs = append(cfgs, &dataplanev1.CreateTopicRequest_Topic_Config{Name: k, Value: &v})
+	}
+	return &dataplanev1.CreateTopicRequest{
+		Topic: &dataplanev1.CreateTopicRequest_Topic{
+			Name:              name,
+			PartitionCount:    s.partitionCount,
+			ReplicationFactor: s.replicationFactor,
+			Configs:           cfgs,
+		},
+	}
+}
+
+// model returns the spec as a topics entry that sets every value itself.
+// Update records it for topics whose change failed, so the next plan shows
+// the difference to what is declared.
+func (s topicSpec) model() (topicmodel.SetTopicModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	cfg := types.MapNull(types.StringType)
+	if len(s.configuration) > 0 {
+		elems := make(map[string]attr.Value, len(s.configuration))
+		for k, v := range s.configuration {
+			elems[k] = types.StringValue(v)
+		}
+		cfg, diags = types.MapValue(types.StringType, elems)
+	}
+	return topicmodel.SetTopicModel{
+		Configuration:     cfg,
+		PartitionCount:    types.Int32PointerValue(s.partitionCount),
+		ReplicationFactor: types.Int32PointerValue(s.replicationFactor),
+	}, diags
+}
+
+// refreshSetTopic refreshes a topics entry from what the broker reports.
+// Values the entry sets itself are always refreshed. Values it leaves to
+// defaults stay unset while the broker agrees with the default, and are
+// recorded on the entry when it does not, so the drift shows up on that
+// topic. Values neither sets are left to the cluster.
+func refreshSetTopic(defaults, entry topicmodel.SetTopicModel, tp *dataplanev1.ListTopicsResponse_Topic, all []*dataplanev1.Topic_Configuration) (topicmodel.SetTopicModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	entry.PartitionCount = refreshSetCount(defaults.PartitionCount, entry.PartitionCount, tp.GetPartitionCount())
+	entry.ReplicationFactor = refreshSetCount(defaults.ReplicationFactor, entry.ReplicationFactor, tp.GetReplicationFactor())
+
+	planned := mergedConfiguration(defaults.Configuration, entry.Configuration)
+	own := entry.Configuration.Elements()
+	elems := map[string]attr.Value{}
+	for _, c := range mergeWithPlannedConfig(filterDynamicConfig(all), all, planned, false) {
+		if c.Value == nil {
+			continue
+		}
+		if _, ok := own[c.GetName()]; !ok {
+			if def, ok := defaults.Configuration.Elements()[c.GetName()].(types.String); ok && topicconfig.Equivalent(c.GetName(), def.ValueString(), c.GetValue()) {
+				continue
+			}
+		}
+		elems[c.GetName()] = types.StringValue(c.GetValue())
+	}
+	if entry.Configuration.IsNull() && len(elems) == 0 {
+		return entry, diags
+	}
+	cfg, d := types.MapValue(types.StringType, elems)
+	diags.Append(d...)
+	entry.Configuration = cfg
+	return entry, diags
+}
+
+func refreshSetCount(def, own types.Int32, reported int32) types.Int32 {
+	if !own.IsNull() || (!def.IsNull() && def.ValueInt32() != reported) {
+		return types.Int32Value(reported)
+	}
+	return own
+}
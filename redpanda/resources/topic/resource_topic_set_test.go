@@ -0,0 +1,305 @@
+package topic
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/mocks"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+)
+
+func setTopic(partitions types.Int32, cfg map[string]string) topicmodel.SetTopicModel {
+	m := types.MapNull(types.StringType)
+	if cfg != nil {
+		elems := make(map[string]attr.Value, len(cfg))
+		for k, v := range cfg {
+			elems[k] = types.StringValue(v)
+		}
+		m = types.MapValueMust(types.StringType, elems)
+	}
+	return topicmodel.SetTopicModel{Configuration: m, PartitionCount: partitions, ReplicationFactor: types.Int32Null()}
+}
+
+func topicSetModel(t *testing.T, defaults *topicmodel.SetTopicModel, topics map[string]topicmodel.SetTopicModel) topicmodel.SetModel {
+	t.Helper()
+	topicsValue, diags := setTopicsValue(topics)
+	require.False(t, diags.HasError(), diags)
+	defaultsValue := types.ObjectNull(topicmodel.SetTopicAttrTypes())
+	if defaults != nil {
+		defaultsValue = types.ObjectValueMust(topicmodel.SetTopicAttrTypes(), map[string]attr.Value{
+			"configuration":      defaults.Configuration,
+			"partition_count":    defaults.PartitionCount,
+			"replication_factor": defaults.ReplicationFactor,
+		})
+	}
+	return topicmodel.SetModel{
+		AllowDeletion: types.BoolValue(false),
+		ClusterAPIURL: types.StringValue(testReplicaAPIURL),
+		Defaults:      defaultsValue,
+		ID:            types.StringValue(testReplicaAPIURL),
+		Parallelism:   types.Int32Value(defaultTopicSetParallelism),
+		Topics:        topicsValue,
+	}
+}
+
+func stateTopics(t *testing.T, state tfsdk.State) map[string]topicmodel.SetTopicModel {
+	t.Helper()
+	var m topicmodel.SetModel
+	require.False(t, state.Get(context.Background(), &m).HasError())
+	topics, _, diags := setTopics(context.Background(), &m)
+	require.False(t, diags.HasError(), diags)
+	return topics
+}
+
+func TestUnit_TopicSet_Create_PartialFailure(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+	mockClient.EXPECT().
+		CreateTopic(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.CreateTopicRequest, _ ...grpc.CallOption) (*dataplanev1.CreateTopicResponse, error) {
+			if req.GetTopic().GetName() == "tenant-b" {
+				return nil, errors.New("INVALID_PARTITIONS")
+			}
+			assert.Equal(t, int32(12), req.GetTopic().GetPartitionCount(), "the topic's own partition count wins")
+			if assert.Len(t, req.GetTopic().GetConfigs(), 2) {
+				assert.Equal(t, "cleanup.policy", req.GetTopic().GetConfigs()[0].GetName())
+				assert.Equal(t, "compact", req.GetTopic().GetConfigs()[0].GetValue())
+				assert.Equal(t, "retention.ms", req.GetTopic().GetConfigs()[1].GetName())
+				assert.Equal(t, "604800000", req.GetTopic().GetConfigs()[1].GetValue(), "defaults are sent normalised")
+			}
+			return &dataplanev1.CreateTopicResponse{TopicName: req.GetTopic().GetName()}, nil
+		})
+
+	r := &TopicSet{TopicClient: mockClient}
+	s := ResourceTopicSetSchema(ctx)
+	defaults := setTopic(types.Int32Value(6), map[string]string{"retention.ms": "7d"})
+	plan := topicSetModel(t, &defaults, map[string]topicmodel.SetTopicModel{
+		"tenant-a": setTopic(types.Int32Value(12), map[string]string{"cleanup.policy": "compact"}),
+		"tenant-b": setTopic(types.Int32Null(), nil),
+	})
+	plan.ID = types.StringUnknown()
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+
+	r.Create(ctx, req, &resp)
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	assert.Equal(t, path.Root("topics").AtMapKey("tenant-b"), resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath).Path())
+
+	topics := stateTopics(t, resp.State)
+	assert.Contains(t, topics, "tenant-a")
+	assert.NotContains(t, topics, "tenant-b", "a topic that was not created is left out of state")
+}
+
+func TestUnit_TopicSet_Read_ListsOnce(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+	mockClient.EXPECT().
+		ListTopics(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.ListTopicsRequest, _ ...grpc.CallOption) (*dataplanev1.ListTopicsResponse, error) {
+			assert.Nil(t, req.GetFilter(), "refresh lists every topic at once")
+			return &dataplanev1.ListTopicsResponse{Topics: []*dataplanev1.ListTopicsResponse_Topic{
+				{Name: "tenant-a", PartitionCount: 6, ReplicationFactor: 3},
+				{Name: "tenant-b", PartitionCount: 9, ReplicationFactor: 3},
+				{Name: "unrelated", PartitionCount: 1, ReplicationFactor: 3},
+			}}, nil
+		})
+	mockClient.EXPECT().
+		GetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		Return(&dataplanev1.GetTopicConfigurationsResponse{Configurations: []*dataplanev1.Topic_Configuration{
+			{Name: "retention.ms", Value: strPtr("604800000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		}}, nil)
+
+	r := &TopicSet{TopicClient: mockClient}
+	s := ResourceTopicSetSchema(ctx)
+	defaults := setTopic(types.Int32Value(6), map[string]string{"retention.ms": "7d"})
+	prior := topicSetModel(t, &defaults, map[string]topicmodel.SetTopicModel{
+		"tenant-a": setTopic(types.Int32Null(), nil),
+		"tenant-b": setTopic(types.Int32Null(), nil),
+		"tenant-c": setTopic(types.Int32Null(), nil),
+	})
+	req := resource.ReadRequest{State: tfsdk.State{Schema: s}}
+	require.False(t, req.State.Set(ctx, &prior).HasError())
+	resp := resource.ReadResponse{State: tfsdk.State{Schema: s}}
+
+	r.Read(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	topics := stateTopics(t, resp.State)
+	assert.NotContains(t, topics, "tenant-c", "a deleted topic is dropped so the next apply recreates it")
+	assert.NotContains(t, topics, "unrelated")
+	assert.True(t, topics["tenant-a"].PartitionCount.IsNull(), "matching the default is not recorded on the topic")
+	assert.True(t, topics["tenant-a"].Configuration.IsNull())
+	assert.Equal(t, int32(9), topics["tenant-b"].PartitionCount.ValueInt32(), "drift from the default shows up on the topic")
+}
+
+func TestUnit_TopicSet_Update(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+	mockClient.EXPECT().
+		CreateTopic(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.CreateTopicRequest, _ ...grpc.CallOption) (*dataplanev1.CreateTopicResponse, error) {
+			assert.Equal(t, "tenant-new", req.GetTopic().GetName())
+			return &dataplanev1.CreateTopicResponse{TopicName: "tenant-new"}, nil
+		})
+	mockClient.EXPECT().
+		DeleteTopic(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.DeleteTopicResponse{}, nil)
+	mockClient.EXPECT().
+		SetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.SetTopicConfigurationsRequest, _ ...grpc.CallOption) (*dataplanev1.SetTopicConfigurationsResponse, error) {
+			if assert.Len(t, req.GetConfigurations(), 1) {
+				assert.Equal(t, "86400000", req.GetConfigurations()[0].GetValue(), "a changed default reaches every topic")
+			}
+			if req.GetTopicName() == "tenant-b" {
+				return nil, errors.New("POLICY_VIOLATION")
+			}
+			return &dataplanev1.SetTopicConfigurationsResponse{}, nil
+		})
+
+	r := &TopicSet{TopicClient: mockClient}
+	s := ResourceTopicSetSchema(ctx)
+	oldDefaults := setTopic(types.Int32Null(), map[string]string{"retention.ms": "7d"})
+	prior := topicSetModel(t, &oldDefaults, map[string]topicmodel.SetTopicModel{
+		"tenant-a":   setTopic(types.Int32Null(), nil),
+		"tenant-b":   setTopic(types.Int32Null(), nil),
+		"tenant-old": setTopic(types.Int32Null(), nil),
+	})
+	newDefaults := setTopic(types.Int32Null(), map[string]string{"retention.ms": "1d"})
+	plan := topicSetModel(t, &newDefaults, map[string]topicmodel.SetTopicModel{
+		"tenant-a":   setTopic(types.Int32Null(), nil),
+		"tenant-b":   setTopic(types.Int32Null(), nil),
+		"tenant-new": setTopic(types.Int32Null(), nil),
+	})
+	plan.AllowDeletion = types.BoolValue(true)
+	req := resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s}, State: tfsdk.State{Schema: s}}
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	require.False(t, req.State.Set(ctx, &prior).HasError())
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: s}}
+
+	r.Update(ctx, req, &resp)
+	require.Equal(t, 1, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+
+	topics := stateTopics(t, resp.State)
+	assert.ElementsMatch(t, []string{"tenant-a", "tenant-b", "tenant-new"}, slices.Collect(maps.Keys(topics)))
+	assert.True(t, topics["tenant-a"].Configuration.IsNull())
+	assert.Equal(t, "604800000", topics["tenant-b"].Configuration.Elements()["retention.ms"].(types.String).ValueString(),
+		"a failed topic keeps its prior settings so the next plan retries it")
+}
+
+func TestUnit_TopicSet_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	s := ResourceTopicSetSchema(ctx)
+	prior := topicSetModel(t, nil, map[string]topicmodel.SetTopicModel{
+		"tenant-a": setTopic(types.Int32Value(6), nil),
+		"tenant-b": setTopic(types.Int32Value(6), nil),
+	})
+	plan := topicSetModel(t, nil, map[string]topicmodel.SetTopicModel{
+		"tenant-a": setTopic(types.Int32Value(3), nil),
+	})
+	req := resource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: s}, State: tfsdk.State{Schema: s}}
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	require.False(t, req.State.Set(ctx, &prior).HasError())
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	(&TopicSet{}).ModifyPlan(ctx, req, &resp)
+	require.Equal(t, 2, resp.Diagnostics.ErrorsCount(), resp.Diagnostics)
+	assert.Equal(t, "Partition count cannot be decreased", resp.Diagnostics.Errors()[0].Summary())
+	assert.Equal(t, "Topic deletion not allowed", resp.Diagnostics.Errors()[1].Summary())
+}
+
+func TestUnit_RefreshSetTopic(t *testing.T) {
+	reported := []*dataplanev1.Topic_Configuration{
+		{Name: "retention.ms", Value: strPtr("604800000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "cleanup.policy", Value: strPtr("compact"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+	}
+	tp := &dataplanev1.ListTopicsResponse_Topic{Name: "tenant-a", PartitionCount: 6, ReplicationFactor: 3}
+
+	tests := []struct {
+		name     string
+		defaults topicmodel.SetTopicModel
+		entry    topicmodel.SetTopicModel
+		wantCfg  map[string]string
+	}{
+		{
+			name:     "keys matching defaults stay on defaults",
+			defaults: setTopic(types.Int32Null(), map[string]string{"retention.ms": "7d", "cleanup.policy": "compact"}),
+			entry:    setTopic(types.Int32Null(), nil),
+		},
+		{
+			name:     "own keys keep their spelling",
+			defaults: setTopic(types.Int32Null(), map[string]string{"cleanup.policy": "compact"}),
+			entry:    setTopic(types.Int32Null(), map[string]string{"retention.ms": "7d"}),
+			wantCfg:  map[string]string{"retention.ms": "7d"},
+		},
+		{
+			name:     "a default the broker disagrees with shows up on the topic",
+			defaults: setTopic(types.Int32Null(), map[string]string{"retention.ms": "1d", "cleanup.policy": "compact"}),
+			entry:    setTopic(types.Int32Null(), nil),
+			wantCfg:  map[string]string{"retention.ms": "604800000"},
+		},
+		{
+			name:     "undeclared overrides show up on the topic",
+			defaults: setTopic(types.Int32Null(), nil),
+			entry:    setTopic(types.Int32Null(), nil),
+			wantCfg:  map[string]string{"retention.ms": "604800000", "cleanup.policy": "compact"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := refreshSetTopic(tt.defaults, tt.entry, tp, reported)
+			require.False(t, diags.HasError(), diags)
+			if tt.wantCfg == nil {
+				assert.True(t, got.Configuration.IsNull(), got.Configuration)
+				return
+			}
+			assert.Equal(t, setTopic(types.Int32Null(), tt.wantCfg).Configuration, got.Configuration)
+		})
+	}
+}
+
+func TestUnit_ForEachTopic_BoundsParallelism(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	names := []string{"a", "b", "c", "d", "e", "f", "g", "h"}
+	errs := forEachTopic(context.Background(), names, 3, func(_ context.Context, name string) error {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		if name == "c" {
+			return errors.New("boom")
+		}
+		return nil
+	})
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs["c"], "boom")
+}
@@ -40,6 +40,8 @@ func TestUnit_Topic_Create(t *testing.T) {
 		{
 			name: "basic topic creation",
 			input: topicmodel.ResourceModel{
+				Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+				TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 				Name:              types.StringValue("test-topic"),
 				PartitionCount:    utils.Int32ToNumber(partitionCount),
 				ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -68,6 +70,8 @@ func TestUnit_Topic_Create(t *testing.T) {
 		{
 			name: "topic with configuration",
 			input: topicmodel.ResourceModel{
+				Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+				TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 				Name:              types.StringValue("configured-topic"),
 				PartitionCount:    utils.Int32ToNumber(partitionCount),
 				ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -110,6 +114,8 @@ func TestUnit_Topic_Create(t *testing.T) {
 		{
 			name: "create fails - API error",
 			input: topicmodel.ResourceModel{
+				Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+				TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 				Name:              types.StringValue("failing-topic"),
 				PartitionCount:    utils.Int32ToNumber(partitionCount),
 				ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -131,6 +137,8 @@ func TestUnit_Topic_Create(t *testing.T) {
 			// Server says "no" with retriable: false metadata; provider must not retry.
 			name: "PermissionDenied fails fast, no retry",
 			input: topicmodel.ResourceModel{
+				Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+				TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 				Name:              types.StringValue("denied-topic"),
 				PartitionCount:    utils.Int32ToNumber(partitionCount),
 				ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -152,6 +160,8 @@ func TestUnit_Topic_Create(t *testing.T) {
 		{
 			name: "state persisted when GetTopicConfigurations fails after create",
 			input: topicmodel.ResourceModel{
+				Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+				TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 				Name:              types.StringValue("orphan-topic"),
 				PartitionCount:    utils.Int32ToNumber(partitionCount),
 				ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -283,6 +293,8 @@ func TestUnit_Topic_CreateStatePersistence(t *testing.T) {
 	schemaResp.Schema = ResourceTopicSchema(ctx)
 
 	input := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:              types.StringValue("bulk-topic"),
 		PartitionCount:    utils.Int32ToNumber(partitionCount),
 		ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -363,6 +375,8 @@ func TestUnit_Topic_UpdateStatePersistence(t *testing.T) {
 	schemaResp.Schema = ResourceTopicSchema(ctx)
 
 	state := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:              types.StringValue("update-topic"),
 		PartitionCount:    utils.Int32ToNumber(partitionCount),
 		ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -378,6 +392,8 @@ func TestUnit_Topic_UpdateStatePersistence(t *testing.T) {
 	}
 
 	plan := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:              types.StringValue("update-topic"),
 		PartitionCount:    utils.Int32ToNumber(partitionCount),
 		ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -502,6 +518,8 @@ func TestUnit_Topic_Read_RetriesTransient(t *testing.T) {
 	schemaResp.Schema = ResourceTopicSchema(ctx)
 
 	state := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:              types.StringValue("retry-topic"),
 		PartitionCount:    utils.Int32ToNumber(partitionCount),
 		ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -558,6 +576,8 @@ func TestUnit_Topic_Update_RetriesTransient(t *testing.T) {
 	schemaResp.Schema = ResourceTopicSchema(ctx)
 
 	base := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:              types.StringValue("update-topic"),
 		PartitionCount:    utils.Int32ToNumber(partitionCount),
 		ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -592,6 +612,319 @@ func TestUnit_Topic_Update_RetriesTransient(t *testing.T) {
 	require.False(t, resp.Diagnostics.HasError(), "Update should succeed after retry: %v", resp.Diagnostics)
 }
 
+// TestUnit_Topic_Update_ReplicationFactorInPlace verifies a replication factor
+// change is applied through the replication.factor topic property and that
+// Update polls the topic until the broker reports the new value.
+func TestUnit_Topic_Update_ReplicationFactorInPlace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+
+	listWithRF := func(rf int32) *dataplanev1.ListTopicsResponse {
+		return &dataplanev1.ListTopicsResponse{
+			Topics: []*dataplanev1.ListTopicsResponse_Topic{{
+				Name:              "rf-topic",
+				PartitionCount:    3,
+				ReplicationFactor: rf,
+			}},
+		}
+	}
+	mockClient.EXPECT().
+		UpdateTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *dataplanev1.UpdateTopicConfigurationsRequest, _ ...any) (*dataplanev1.UpdateTopicConfigurationsResponse, error) {
+			require.Len(t, req.GetConfigurations(), 1)
+			cfg := req.GetConfigurations()[0]
+			assert.Equal(t, "rf-topic", req.GetTopicName())
+			assert.Equal(t, "replication.factor", cfg.GetName())
+			assert.Equal(t, "3", cfg.GetValue())
+			assert.Equal(t, dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_SET, cfg.GetOperation())
+			return &dataplanev1.UpdateTopicConfigurationsResponse{}, nil
+		})
+	gomock.InOrder(
+		mockClient.EXPECT().
+			ListTopics(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(listWithRF(1), nil),
+		mockClient.EXPECT().
+			ListTopics(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(listWithRF(3), nil),
+	)
+	mockClient.EXPECT().
+		GetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&dataplanev1.GetTopicConfigurationsResponse{
+			Configurations: []*dataplanev1.Topic_Configuration{},
+		}, nil)
+
+	topic := &Topic{
+		clientFactory: func(_ string, _ oauth2.TokenSource, _, _ string) (dataplanev1grpc.TopicServiceClient, error) {
+			return mockClient, nil
+		},
+		resData: config.Resource{TokenSource: testTokenSource(), ProviderVersion: "1.0.0", TerraformVersion: "1.5.0"},
+	}
+	schemaResp := resource.SchemaResponse{}
+	schemaResp.Schema = ResourceTopicSchema(ctx)
+
+	state := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
+		Name:              types.StringValue("rf-topic"),
+		PartitionCount:    utils.Int32ToNumber(3),
+		ReplicationFactor: utils.Int32ToNumber(1),
+		Configuration:     types.MapNull(types.StringType),
+		ClusterAPIURL:     types.StringValue("https://api-test.cluster.redpanda.com"),
+		AllowDeletion:     types.BoolValue(true),
+		ID:                types.StringValue("rf-topic"),
+		ReplicaAssignments: types.ListNull(types.ObjectType{
+			AttrTypes: replicaAssignmentAttrTypes(),
+		}),
+	}
+	plan := state
+	plan.ReplicationFactor = utils.Int32ToNumber(3)
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+	}
+	require.False(t, req.State.Set(ctx, &state).HasError())
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	topic.Update(ctx, req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update should succeed: %v", resp.Diagnostics)
+	var result topicmodel.ResourceModel
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, int32(3), *utils.NumberToInt32(result.ReplicationFactor))
+}
+
+// TestUnit_Topic_Update_AuthoritativeConfiguration verifies authoritative
+// mode diffs the plan against the overrides the broker reports, not prior
+// state, and records exactly the planned configuration. The prior state comes
+// from additive mode, so it lacks the redpanda.remote.read override set with
+// rpk; the switch to authoritative must still delete it.
+func TestUnit_Topic_Update_AuthoritativeConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	mockClient := mocks.NewMockTopicServiceClient(ctrl)
+
+	dynamic := func(kv ...string) *dataplanev1.GetTopicConfigurationsResponse {
+		res := &dataplanev1.GetTopicConfigurationsResponse{}
+		for i := 0; i < len(kv); i += 2 {
+			res.Configurations = append(res.Configurations, &dataplanev1.Topic_Configuration{
+				Name: kv[i], Value: strPtr(kv[i+1]), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG,
+			})
+		}
+		return res
+	}
+	gomock.InOrder(
+		mockClient.EXPECT().
+			GetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dynamic("retention.ms", "1000", "cleanup.policy", "compact", "redpanda.remote.read", "true", "redpanda.storage.mode", "unset"), nil),
+		mockClient.EXPECT().
+			UpdateTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *dataplanev1.UpdateTopicConfigurationsRequest, _ ...any) (*dataplanev1.UpdateTopicConfigurationsResponse, error) {
+				ops := req.GetConfigurations()
+				if assert.Len(t, ops, 3) {
+					assert.Equal(t, "cleanup.policy", ops[0].GetName())
+					assert.Equal(t, dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_DELETE, ops[0].GetOperation())
+					assert.Equal(t, "redpanda.remote.read", ops[1].GetName())
+					assert.Equal(t, dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_DELETE, ops[1].GetOperation())
+					assert.Equal(t, "retention.ms", ops[2].GetName())
+					assert.Equal(t, "2000", ops[2].GetValue())
+					assert.Equal(t, dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_SET, ops[2].GetOperation())
+				}
+				return &dataplanev1.UpdateTopicConfigurationsResponse{}, nil
+			}),
+		// The broker sets an override of its own while applying.
+		mockClient.EXPECT().
+			GetTopicConfigurations(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(dynamic("retention.ms", "2000", "redpanda.remote.write", "true"), nil),
+	)
+
+	topic := &Topic{
+		clientFactory: func(_ string, _ oauth2.TokenSource, _, _ string) (dataplanev1grpc.TopicServiceClient, error) {
+			return mockClient, nil
+		},
+		resData: config.Resource{TokenSource: testTokenSource(), ProviderVersion: "1.0.0", TerraformVersion: "1.5.0"},
+	}
+	schemaResp := resource.SchemaResponse{}
+	schemaResp.Schema = ResourceTopicSchema(ctx)
+
+	state := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
+		Name:              types.StringValue("auth-topic"),
+		PartitionCount:    utils.Int32ToNumber(3),
+		ReplicationFactor: utils.Int32ToNumber(3),
+		Configuration: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"retention.ms":   types.StringValue("1000"),
+			"cleanup.policy": types.StringValue("compact"),
+		}),
+		ConfigurationMode: types.StringValue("additive"),
+		ClusterAPIURL:     types.StringValue("https://api-test.cluster.redpanda.com"),
+		AllowDeletion:     types.BoolValue(true),
+		ID:                types.StringValue("auth-topic"),
+		ReplicaAssignments: types.ListNull(types.ObjectType{
+			AttrTypes: replicaAssignmentAttrTypes(),
+		}),
+	}
+	plan := state
+	plan.ConfigurationMode = types.StringValue("authoritative")
+	plan.Configuration = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"retention.ms": types.StringValue("2000"),
+	})
+
+	req := resource.UpdateRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+	}
+	require.False(t, req.State.Set(ctx, &state).HasError())
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	topic.Update(ctx, req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "Update should succeed: %v", resp.Diagnostics)
+	var result topicmodel.ResourceModel
+	require.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.True(t, plan.Configuration.Equal(result.Configuration), "state after apply must equal the plan, got %v", result.Configuration)
+}
+
+// TestUnit_Topic_LiveConfiguration covers which broker-reported overrides
+// authoritative mode reconciles.
+func TestUnit_Topic_LiveConfiguration(t *testing.T) {
+	all := []*dataplanev1.Topic_Configuration{
+		{Name: "retention.ms", Value: strPtr("1000"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "cleanup.policy", Value: strPtr("delete"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DEFAULT_CONFIG},
+		{Name: "redpanda.remote.write", Value: strPtr("true"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "redpanda.storage.mode", Value: strPtr("unset"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "replication.factor", Value: strPtr("3"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+		{Name: "redpanda.remote.readreplica", Value: strPtr("bucket"), Source: dataplanev1.ConfigSource_CONFIG_SOURCE_DYNAMIC_TOPIC_CONFIG},
+	}
+
+	got := liveConfiguration(all, types.MapValueMust(types.StringType, map[string]attr.Value{}))
+	assert.Equal(t, map[string]attr.Value{
+		"retention.ms":          types.StringValue("1000"),
+		"redpanda.remote.write": types.StringValue("true"),
+	}, got.Elements(), "undeclared read-only properties cannot be removed and are skipped")
+
+	got = liveConfiguration(all, types.MapValueMust(types.StringType, map[string]attr.Value{
+		"redpanda.remote.readreplica": types.StringValue("bucket"),
+	}))
+	assert.Contains(t, got.Elements(), "redpanda.remote.readreplica", "declared read-only properties are compared, so they are not set again")
+}
+
+func TestUnit_Topic_ChangedReadOnlyConfigs(t *testing.T) {
+	cfg := func(elems map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.StringType, elems)
+	}
+	state := cfg(map[string]attr.Value{
+		"redpanda.remote.readreplica": types.StringValue("bucket-a"),
+		"retention.ms":                types.StringValue("1000"),
+	})
+
+	assert.Empty(t, changedReadOnlyConfigs(cfg(map[string]attr.Value{
+		"redpanda.remote.readreplica": types.StringValue("bucket-a"),
+		"retention.ms":                types.StringValue("2000"),
+	}), state), "writable properties may change")
+	assert.Equal(t, []string{"redpanda.remote.readreplica"}, changedReadOnlyConfigs(cfg(map[string]attr.Value{
+		"redpanda.remote.readreplica": types.StringValue("bucket-b"),
+	}), state))
+	assert.Equal(t, []string{"redpanda.remote.readreplica"}, changedReadOnlyConfigs(types.MapNull(types.StringType), state),
+		"removing a read-only property is a change")
+	assert.Equal(t, []string{"redpanda.remote.recovery"}, changedReadOnlyConfigs(cfg(map[string]attr.Value{
+		"redpanda.remote.readreplica": types.StringValue("bucket-a"),
+		"redpanda.remote.recovery":    types.StringValue("true"),
+	}), state), "adding a read-only property is a change")
+	assert.Empty(t, changedReadOnlyConfigs(cfg(map[string]attr.Value{
+		"redpanda.remote.readreplica": types.StringUnknown(),
+	}), state), "unknown values are checked once known")
+}
+
+func TestUnit_Topic_ReplicationFactorChanged(t *testing.T) {
+	tests := []struct {
+		name        string
+		plan, state types.Number
+		want        bool
+	}{
+		{"increase", utils.Int32ToNumber(3), utils.Int32ToNumber(1), true},
+		{"decrease", utils.Int32ToNumber(1), utils.Int32ToNumber(3), true},
+		{"unchanged", utils.Int32ToNumber(3), utils.Int32ToNumber(3), false},
+		{"manual assignment", utils.Int32ToNumber(-1), utils.Int32ToNumber(3), false},
+		{"null plan", types.NumberNull(), utils.Int32ToNumber(3), false},
+		{"unknown plan", types.NumberUnknown(), utils.Int32ToNumber(3), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, replicationFactorChanged(tt.plan, tt.state))
+		})
+	}
+}
+
+func TestUnit_Topic_ConfigurationAlterations(t *testing.T) {
+	cfg := func(elems map[string]string) types.Map {
+		vals := make(map[string]attr.Value, len(elems))
+		for k, v := range elems {
+			vals[k] = types.StringValue(v)
+		}
+		return types.MapValueMust(types.StringType, vals)
+	}
+	type op struct {
+		name, value string
+		operation   dataplanev1.ConfigAlterOperation
+	}
+	set, del := dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_SET, dataplanev1.ConfigAlterOperation_CONFIG_ALTER_OPERATION_DELETE
+
+	tests := []struct {
+		name           string
+		prior, planned types.Map
+		want           []op
+	}{
+		{
+			name:    "no change",
+			prior:   cfg(map[string]string{"retention.ms": "604800000"}),
+			planned: cfg(map[string]string{"retention.ms": "7d"}),
+		},
+		{
+			name:    "set and delete sorted by key",
+			prior:   cfg(map[string]string{"segment.bytes": "1048576", "cleanup.policy": "delete", "retention.ms": "1000"}),
+			planned: cfg(map[string]string{"retention.ms": "2000", "compression.type": "zstd", "cleanup.policy": "delete"}),
+			want: []op{
+				{"compression.type", "zstd", set},
+				{"retention.ms", "2000", set},
+				{"segment.bytes", "", del},
+			},
+		},
+		{
+			name:    "null planned deletes everything",
+			prior:   cfg(map[string]string{"retention.ms": "1000"}),
+			planned: types.MapNull(types.StringType),
+			want:    []op{{"retention.ms", "", del}},
+		},
+		{
+			name:    "null prior sets everything",
+			prior:   types.MapNull(types.StringType),
+			planned: cfg(map[string]string{"retention.bytes": "1GiB"}),
+			want:    []op{{"retention.bytes", "1073741824", set}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ops, err := configurationAlterations(tt.prior, tt.planned)
+			require.NoError(t, err)
+			got := make([]op, 0, len(ops))
+			for _, o := range ops {
+				got = append(got, op{o.GetName(), o.GetValue(), o.GetOperation()})
+			}
+			assert.Equal(t, len(tt.want), len(got))
+			if len(tt.want) > 0 {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
 // TestUnit_Topic_Delete_NotFoundAfterRetryIsSuccess verifies Delete treats a
 // NOT_FOUND response after an initial transient error as a successful delete.
 func TestUnit_Topic_Delete_NotFoundAfterRetryIsSuccess(t *testing.T) {
@@ -622,6 +955,8 @@ func TestUnit_Topic_Delete_NotFoundAfterRetryIsSuccess(t *testing.T) {
 	schemaResp.Schema = ResourceTopicSchema(ctx)
 
 	state := topicmodel.ResourceModel{
+		Iceberg:           types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:     types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:              types.StringValue("delete-topic"),
 		PartitionCount:    utils.Int32ToNumber(partitionCount),
 		ReplicationFactor: utils.Int32ToNumber(replicationFactor),
@@ -706,7 +1041,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 		}
 		planned := mkPlanned(map[string]string{"compression.type": "gzip"})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
 			"redpanda.storage.mode must be stripped when user did not name it")
@@ -722,7 +1057,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			"redpanda.storage.mode": "tiered",
 		})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type", "redpanda.storage.mode"}, names(got),
 			"user-named redpanda.* keys must be preserved")
@@ -734,12 +1069,40 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			mkCfg("redpanda.storage.mode", "unset"),
 		}
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, types.MapNull(types.StringType))
+		got := mergeWithPlannedConfig(dynamic, dynamic, types.MapNull(types.StringType), false)
 
 		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
 			"redpanda.* server-injected keys must be stripped even when plan is null")
 	})
 
+	t.Run("replication.factor is stripped unless planned", func(t *testing.T) {
+		dynamic := []*dataplanev1.Topic_Configuration{
+			mkCfg("compression.type", "gzip"),
+			mkCfg("replication.factor", "3"),
+		}
+
+		got := mergeWithPlannedConfig(dynamic, dynamic, mkPlanned(map[string]string{"compression.type": "gzip"}), false)
+		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
+			"replication.factor is tracked by the replication_factor attribute")
+
+		got = mergeWithPlannedConfig(dynamic, dynamic, mkPlanned(map[string]string{"replication.factor": "3"}), false)
+		assert.ElementsMatch(t, []string{"compression.type", "replication.factor"}, names(got))
+	})
+
+	t.Run("planned unit spelling survives normalised broker value", func(t *testing.T) {
+		dynamic := []*dataplanev1.Topic_Configuration{
+			mkCfg("retention.ms", "604800000"),
+			mkCfg("segment.bytes", "1073741824"),
+		}
+		planned := mkPlanned(map[string]string{"retention.ms": "7d", "segment.bytes": "2GiB"})
+
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
+
+		assert.Equal(t, "7d", valueOf(got, "retention.ms"), "equivalent value keeps the planned spelling")
+		assert.Equal(t, "1073741824", valueOf(got, "segment.bytes"), "a different value is reported as drift")
+		assert.Equal(t, "604800000", dynamic[0].GetValue(), "broker response must not be mutated")
+	})
+
 	t.Run("non-redpanda server keys pass through", func(t *testing.T) {
 		dynamic := []*dataplanev1.Topic_Configuration{
 			mkCfg("compression.type", "gzip"),
@@ -747,7 +1110,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 		}
 		planned := mkPlanned(map[string]string{"compression.type": "gzip"})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type", "retention.ms"}, names(got),
 			"non-redpanda.* keys (kafka-side configs) must pass through unchanged")
@@ -766,7 +1129,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			"retention.ms":     "604800000",
 		})
 
-		got := mergeWithPlannedConfig(dynamic, all, planned)
+		got := mergeWithPlannedConfig(dynamic, all, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type", "retention.ms"}, names(got),
 			"user-set keys reported with non-dynamic source must be reinstated from allConfigs")
@@ -783,7 +1146,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			"min.insync.replicas": "2",
 		})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type", "min.insync.replicas"}, names(got),
 			"server-silent keys (not echoed by the broker) must be synthesized from the plan")
@@ -800,7 +1163,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			"min.insync.replicas": types.StringNull(),
 		})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
 			"null plan values must not synthesize a topic-config entry")
@@ -815,7 +1178,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			"min.insync.replicas": types.StringUnknown(),
 		})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
 			"unknown plan values must not synthesize a topic-config entry")
@@ -829,7 +1192,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 		}
 		planned := mkPlanned(map[string]string{"compression.type": "gzip"})
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, planned)
+		got := mergeWithPlannedConfig(dynamic, dynamic, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
 			"nil entries must not appear in the merged result")
@@ -844,7 +1207,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			mkCfg("redpanda.storage.mode", "unset"),
 		}
 
-		got := mergeWithPlannedConfig(dynamic, dynamic, types.MapUnknown(types.StringType))
+		got := mergeWithPlannedConfig(dynamic, dynamic, types.MapUnknown(types.StringType), false)
 
 		assert.ElementsMatch(t, []string{"compression.type"}, names(got),
 			"unknown plan map must trigger the same redpanda.* stripping as a null plan")
@@ -860,7 +1223,7 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 		}
 		planned := mkPlanned(map[string]string{"compression.type": "gzip"})
 
-		got := mergeWithPlannedConfig(dynamic, all, planned)
+		got := mergeWithPlannedConfig(dynamic, all, planned, false)
 
 		assert.Equal(t, 1, len(got),
 			"a planned key already present from dynamicConfigs must not be re-added from allConfigs")
@@ -878,11 +1241,25 @@ func TestUnit_Topic_MergeWithPlannedConfig(t *testing.T) {
 			"retention.ms":     "604800000",
 		})
 
-		got := mergeWithPlannedConfig(nil, all, planned)
+		got := mergeWithPlannedConfig(nil, all, planned, false)
 
 		assert.ElementsMatch(t, []string{"compression.type", "retention.ms"}, names(got),
 			"every planned key found in allConfigs must be reinstated when dynamicConfigs is empty")
 	})
+
+	t.Run("authoritative mode keeps broker-set redpanda.* keys", func(t *testing.T) {
+		dynamic := []*dataplanev1.Topic_Configuration{
+			mkCfg("compression.type", "gzip"),
+			mkCfg("redpanda.remote.write", "true"),
+			mkCfg("redpanda.storage.mode", "unset"),
+			mkCfg("replication.factor", "3"),
+		}
+
+		got := mergeWithPlannedConfig(dynamic, dynamic, mkPlanned(map[string]string{"compression.type": "gzip"}), true)
+
+		assert.ElementsMatch(t, []string{"compression.type", "redpanda.remote.write"}, names(got),
+			"authoritative mode must surface every dynamic key except the unset storage mode and replication.factor")
+	})
 }
 
 func TestUnit_Topic_UpgradeState_NormalizesClusterAPIURL(t *testing.T) {
@@ -897,6 +1274,8 @@ func TestUnit_Topic_UpgradeState_NormalizesClusterAPIURL(t *testing.T) {
 
 	prior := tfsdk.State{Schema: *up.PriorSchema}
 	require.False(t, prior.Set(ctx, &topicmodel.ResourceModel{
+		Iceberg:            types.ObjectNull(topicmodel.IcebergAttrTypes()),
+		TieredStorage:      types.ObjectNull(topicmodel.TieredStorageAttrTypes()),
 		Name:               types.StringValue("app"),
 		ClusterAPIURL:      types.StringValue("api-abc.cid.byoc.prd.cloud.redpanda.com:443"),
 		Configuration:      types.MapNull(cfgElem),
@@ -19,14 +19,17 @@ package topic
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 )
 
 // ResourceTopicSchema returns the Terraform schema for the topic resource.
@@ -46,10 +49,31 @@ func ResourceTopicSchema(_ context.Context) schema.Schema {
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 
+			"iceberg": schema.SingleNestedAttribute{
+				Description: "Iceberg integration settings for the topic. Each set attribute is written to the matching `redpanda.iceberg.*` topic property, which must then not also appear in `configuration`. Enabling Iceberg is checked at plan time against the cluster's `iceberg_enabled` cluster property when the cluster can be resolved from `cluster_api_url`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"invalid_record_action": schema.StringAttribute{
+						Description: "What to do with records that cannot be translated to the Iceberg table (`redpanda.iceberg.invalid.record.action`): `drop` or `dlq_table`. Uses the cluster default when unset.",
+						Optional:    true,
+						Validators:  []validator.String{validators.TopicProperty("redpanda.iceberg.invalid.record.action")},
+					},
+					"mode": schema.StringAttribute{
+						Description: "Iceberg mode for the topic (`redpanda.iceberg.mode`). One of `disabled`, `key_value`, `value_schema_id_prefix` or `value_schema_latest`; `value_schema_latest` accepts options such as `value_schema_latest:subject=orders`.",
+						Required:    true,
+						Validators:  []validator.String{validators.TopicProperty("redpanda.iceberg.mode")},
+					},
+					"partition_spec": schema.StringAttribute{
+						Description: "Iceberg partition spec for the topic's table (`redpanda.iceberg.partition.spec`), e.g. `(hour(redpanda.timestamp))`. Supports bare fields and the `identity`, `year`, `month`, `day`, `hour`, `bucket`, `truncate` and `void` transforms. Uses the cluster default when unset.",
+						Optional:    true,
+						Validators:  []validator.String{validators.TopicProperty("redpanda.iceberg.partition.spec")},
+					},
+				},
+			},
+
 			"replica_assignments": schema.ListNestedAttribute{
-				Description:   "Manually specify broker ID assignments for partition replicas. If manually assigning replicas, both `replication_factor` and `partition_count` must be -1.",
-				Optional:      true,
-				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+				Description: "Manually specify broker ID assignments for partition replicas. If manually assigning replicas, both `replication_factor` and `partition_count` must be -1. Cannot be changed after the topic is created.",
+				Optional:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"partition_id": schema.Int32Attribute{
@@ -65,6 +89,31 @@ func ResourceTopicSchema(_ context.Context) schema.Schema {
 				},
 			},
 
+			"tiered_storage": schema.SingleNestedAttribute{
+				Description: "Tiered storage settings for the topic. Each set attribute is written to the matching topic property, which must then not also appear in `configuration`. Unset attributes use the cluster defaults.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"local_retention_bytes": schema.StringAttribute{
+						Description: "How much data each partition keeps on local disk once it has been uploaded to object storage (`retention.local.target.bytes`), as a byte count or a size such as `1GiB`. `-1` keeps everything locally.",
+						Optional:    true,
+						Validators:  []validator.String{validators.TopicProperty("retention.local.target.bytes")},
+					},
+					"local_retention_ms": schema.StringAttribute{
+						Description: "How long data is kept on local disk once it has been uploaded to object storage (`retention.local.target.ms`), in milliseconds or as a duration such as `1d`. `-1` keeps everything locally.",
+						Optional:    true,
+						Validators:  []validator.String{validators.TopicProperty("retention.local.target.ms")},
+					},
+					"remote_read": schema.BoolAttribute{
+						Description: "Whether the topic serves reads of data that has been moved to object storage (`redpanda.remote.read`).",
+						Optional:    true,
+					},
+					"remote_write": schema.BoolAttribute{
+						Description: "Whether the topic uploads its data to object storage (`redpanda.remote.write`).",
+						Optional:    true,
+					},
+				},
+			},
+
 			"allow_deletion": schema.BoolAttribute{
 				Description: "Whether Terraform may destroy this resource. Defaults to false; set to true to enable destruction. After `terraform import`, defaults to false — set to true in your config before running `terraform destroy`.",
 				Optional:    true,
@@ -73,13 +122,22 @@ func ResourceTopicSchema(_ context.Context) schema.Schema {
 			},
 
 			"configuration": schema.MapAttribute{
-				Description:   "A map of string key/value pairs of topic configurations.",
+				Description:   "A map of string key/value pairs of topic configurations. Keys and values are checked at plan time against the provider's catalog of Redpanda topic properties. Millisecond properties also accept durations such as `7d` and byte properties accept sizes such as `1GiB`.",
 				Optional:      true,
 				Computed:      true,
 				PlanModifiers: []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+				Validators:    []validator.Map{validators.TopicConfiguration()},
 				ElementType:   types.StringType,
 			},
 
+			"configuration_mode": schema.StringAttribute{
+				Description: "How `configuration` is reconciled with the topic's dynamic overrides. `additive` (default) tracks the declared keys and other overrides, ignores server-managed `redpanda.*` keys that are not declared, and applies changes by rewriting the topic configuration. `authoritative` reports every dynamic override as drift, including `redpanda.*` keys, and applies changes with incremental set and delete operations, removing overrides that are not declared.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("additive"),
+				Validators:  []validator.String{stringvalidator.OneOf("additive", "authoritative")},
+			},
+
 			"partition_count": schema.NumberAttribute{
 				Description:   "The number of partitions for the topic. Increases are fully supported without data loss. Decreases will destroy and recreate the topic if allow_deletion is set to true (defaults to false). Must be at least -1.",
 				Optional:      true,
@@ -88,10 +146,10 @@ func ResourceTopicSchema(_ context.Context) schema.Schema {
 			},
 
 			"replication_factor": schema.NumberAttribute{
-				Description:   "The number of replicas every partition must have. If specifying partitions manually (see `replica_assignments`), set to -1. Or, to use the cluster default replication factor, set to null. Must be between -1 and 5 (inclusive).",
+				Description:   "The number of replicas every partition must have. Changes are applied in place: the provider sets the replication.factor topic property and Redpanda moves partition replicas in the background. The apply does not wait for the replicas to finish moving. If specifying partitions manually (see `replica_assignments`), set to -1. Or, to use the cluster default replication factor, set to null. Must be between -1 and 5 (inclusive).",
 				Optional:      true,
 				Computed:      true,
-				PlanModifiers: []planmodifier.Number{numberplanmodifier.RequiresReplace(), numberplanmodifier.UseStateForUnknown()},
+				PlanModifiers: []planmodifier.Number{numberplanmodifier.UseStateForUnknown()},
 			},
 
 			"id": schema.StringAttribute{
@@ -0,0 +1,42 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+package topic
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	topicmodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/topic"
+)
+
+// Topic properties written from the tiered_storage attribute.
+const (
+	remoteReadConfig          = "redpanda.remote.read"
+	remoteWriteConfig         = "redpanda.remote.write"
+	localRetentionBytesConfig = "retention.local.target.bytes"
+	localRetentionMsConfig    = "retention.local.target.ms"
+)
+
+// tieredStorageBlock is the tiered_storage attribute, which sets whether the
+// topic reads from and writes to object storage and how much data stays on
+// local disk.
+var tieredStorageBlock = configBlock{
+	attr: "tiered_storage",
+	properties: map[string]string{
+		"remote_read":           remoteReadConfig,
+		"remote_write":          remoteWriteConfig,
+		"local_retention_bytes": localRetentionBytesConfig,
+		"local_retention_ms":    localRetentionMsConfig,
+	},
+	field: func(m *topicmodel.ResourceModel) *types.Object { return &m.TieredStorage },
+}
@@ -0,0 +1,192 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package user contains the implementation of the User resource following the Terraform framework interfaces.
+
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
+	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	usermodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/user"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+const (
+	lowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	upperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars   = "0123456789"
+	specialChars = "!#$%&*()-_=+[]{}<>:?"
+)
+
+// generatePassword returns a random password of the given length drawn from
+// crypto/rand. Every enabled character class appears at least once.
+func generatePassword(length int, special bool) (string, error) {
+	classes := []string{lowerChars, upperChars, digitChars}
+	if special {
+		classes = append(classes, specialChars)
+	}
+	if length < len(classes) {
+		return "", fmt.Errorf("password length %d is too short to include every character class", length)
+	}
+
+	var all string
+	for _, c := range classes {
+		all += c
+	}
+	out := make([]byte, length)
+	for i := range out {
+		set := all
+		if i < len(classes) {
+			set = classes[i]
+		}
+		b, err := randomChar(set)
+		if err != nil {
+			return "", err
+		}
+		out[i] = b
+	}
+	// Shuffle so the guaranteed characters are not always at the front.
+	for i := len(out) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		out[i], out[j.Int64()] = out[j.Int64()], out[i]
+	}
+	return string(out), nil
+}
+
+func randomChar(set string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(set))))
+	if err != nil {
+		return 0, err
+	}
+	return set[n.Int64()], nil
+}
+
+// plannedGeneratedAt returns the password_generated_at value to plan. Unknown
+// means Create or Update generates a new password: there is none yet, the
+// generate_password policy or mechanism changed, or rotate_after has elapsed.
+func plannedGeneratedAt(plan, state *usermodel.ResourceModel, now time.Time) types.String {
+	if plan.GeneratePassword.IsNull() {
+		return types.StringNull()
+	}
+	if state == nil || state.PasswordGeneratedAt.IsNull() || state.PasswordGeneratedAt.IsUnknown() {
+		return types.StringUnknown()
+	}
+	if !plan.GeneratePassword.Equal(state.GeneratePassword) {
+		return types.StringUnknown()
+	}
+	// SCRAM credentials are derived per mechanism, so switching it needs the
+	// cleartext password again.
+	if !plan.Mechanism.IsUnknown() && !plan.Mechanism.Equal(state.Mechanism) {
+		return types.StringUnknown()
+	}
+	if rotationDue(plan.RotateAfter, state.PasswordGeneratedAt, now) {
+		return types.StringUnknown()
+	}
+	return state.PasswordGeneratedAt
+}
+
+// rotationDue reports whether rotateAfter has elapsed since generatedAt. An
+// unparsable timestamp counts as due so the next apply repairs it.
+func rotationDue(rotateAfter, generatedAt types.String, now time.Time) bool {
+	if rotateAfter.IsNull() || rotateAfter.IsUnknown() {
+		return false
+	}
+	d, err := time.ParseDuration(rotateAfter.ValueString())
+	if err != nil || d <= 0 {
+		return false
+	}
+	at, err := time.Parse(time.RFC3339, generatedAt.ValueString())
+	if err != nil {
+		return true
+	}
+	return !now.Before(at.Add(d))
+}
+
+// newGeneratedPassword generates a password per the generate_password policy
+// and writes it to the configured secret. The secret is written before the
+// user is created or updated, so a failed apply never leaves the user with a
+// password nobody can read; the next apply simply generates another one.
+func (u *User) newGeneratedPassword(ctx context.Context, m *usermodel.ResourceModel) (string, error) {
+	policy, diags := m.AsGeneratePassword(ctx)
+	if diags.HasError() {
+		return "", fmt.Errorf("unable to read generate_password: %v", diags.Errors())
+	}
+	if policy == nil {
+		return "", errors.New("generate_password is not set")
+	}
+	password, err := generatePassword(int(policy.Length.ValueInt64()), policy.Special.ValueBool())
+	if err != nil {
+		return "", err
+	}
+	if err := u.createSecretClient(ctx, m.ClusterAPIURL.ValueString()); err != nil {
+		return "", fmt.Errorf("failed to create secret client: %w", err)
+	}
+	if err := storeGeneratedPassword(ctx, u.SecretClient, policy.SecretName.ValueString(), password); err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+// storeGeneratedPassword replaces the data of an existing secret with the
+// Base64-encoded password, keeping its scopes and labels.
+func storeGeneratedPassword(ctx context.Context, cl dataplanev1grpc.SecretServiceClient, secretName, password string) error {
+	var current *dataplanev1.Secret
+	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		got, rpcErr := cl.GetSecret(ctx, &dataplanev1.GetSecretRequest{Id: secretName})
+		if rpcErr != nil {
+			if utils.IsUnavailable(rpcErr) {
+				return utils.RetryableError(rpcErr)
+			}
+			return utils.NonRetryableError(rpcErr)
+		}
+		current = got.GetSecret()
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read secret %q: %w", secretName, err)
+	}
+
+	req := &dataplanev1.UpdateSecretRequest{
+		Id:         secretName,
+		Scopes:     current.GetScopes(),
+		Labels:     current.GetLabels(),
+		SecretData: []byte(base64.StdEncoding.EncodeToString([]byte(password))),
+	}
+	err = utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
+		if _, rpcErr := cl.UpdateSecret(ctx, req); rpcErr != nil {
+			if utils.IsUnavailable(rpcErr) {
+				return utils.RetryableError(rpcErr)
+			}
+			return utils.NonRetryableError(rpcErr)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write generated password to secret %q: %w", secretName, err)
+	}
+	return nil
+}
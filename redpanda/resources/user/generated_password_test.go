@@ -0,0 +1,139 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//	Licensed under the Apache License, Version 2.0 (the "License");
+//	you may not use this file except in compliance with the License.
+//	You may obtain a copy of the License at
+//
+//	  http://www.apache.org/licenses/LICENSE-2.0
+//
+//	Unless required by applicable law or agreed to in writing, software
+//	distributed under the License is distributed on an "AS IS" BASIS,
+//	WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//	See the License for the specific language governing permissions and
+//	limitations under the License.
+
+// Package user contains the implementation of the User resource following the Terraform framework interfaces.
+
+package user
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	usermodel "github.com/redpanda-data/terraform-provider-redpanda/redpanda/models/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	for _, special := range []bool{true, false} {
+		pw, err := generatePassword(16, special)
+		require.NoError(t, err)
+		assert.Len(t, pw, 16)
+		assert.True(t, strings.ContainsAny(pw, lowerChars))
+		assert.True(t, strings.ContainsAny(pw, upperChars))
+		assert.True(t, strings.ContainsAny(pw, digitChars))
+		assert.Equal(t, special, strings.ContainsAny(pw, specialChars))
+	}
+
+	a, err := generatePassword(32, true)
+	require.NoError(t, err)
+	b, err := generatePassword(32, true)
+	require.NoError(t, err)
+	assert.NotEqual(t, a, b)
+
+	_, err = generatePassword(3, true)
+	assert.Error(t, err)
+}
+
+func TestPlannedGeneratedAt(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	generatedAt := types.StringValue(now.Add(-48 * time.Hour).Format(time.RFC3339))
+
+	policy := func(length int64) types.Object {
+		return types.ObjectValueMust(usermodel.GeneratePasswordAttrTypes(), map[string]attr.Value{
+			"length":      types.Int64Value(length),
+			"special":     types.BoolValue(true),
+			"secret_name": types.StringValue("APP_PASSWORD"),
+		})
+	}
+	model := func(gen types.Object, rotateAfter, at types.String) *usermodel.ResourceModel {
+		return &usermodel.ResourceModel{
+			GeneratePassword:    gen,
+			RotateAfter:         rotateAfter,
+			PasswordGeneratedAt: at,
+			Mechanism:           types.StringValue("scram-sha-256"),
+		}
+	}
+	noGen := types.ObjectNull(usermodel.GeneratePasswordAttrTypes())
+
+	tests := []struct {
+		name  string
+		plan  *usermodel.ResourceModel
+		state *usermodel.ResourceModel
+		want  types.String
+	}{
+		{
+			name: "not generated",
+			plan: model(noGen, types.StringNull(), types.StringUnknown()),
+			want: types.StringNull(),
+		},
+		{
+			name: "create",
+			plan: model(policy(32), types.StringNull(), types.StringUnknown()),
+			want: types.StringUnknown(),
+		},
+		{
+			name:  "switching from a supplied password",
+			plan:  model(policy(32), types.StringNull(), types.StringUnknown()),
+			state: model(noGen, types.StringNull(), types.StringNull()),
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "unchanged without rotate_after",
+			plan:  model(policy(32), types.StringNull(), types.StringUnknown()),
+			state: model(policy(32), types.StringNull(), generatedAt),
+			want:  generatedAt,
+		},
+		{
+			name:  "policy changed",
+			plan:  model(policy(64), types.StringNull(), types.StringUnknown()),
+			state: model(policy(32), types.StringNull(), generatedAt),
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "rotate_after not yet elapsed",
+			plan:  model(policy(32), types.StringValue("72h"), types.StringUnknown()),
+			state: model(policy(32), types.StringValue("72h"), generatedAt),
+			want:  generatedAt,
+		},
+		{
+			name:  "rotate_after elapsed",
+			plan:  model(policy(32), types.StringValue("24h"), types.StringUnknown()),
+			state: model(policy(32), types.StringValue("24h"), generatedAt),
+			want:  types.StringUnknown(),
+		},
+		{
+			name:  "previous secret write failed",
+			plan:  model(policy(32), types.StringNull(), types.StringUnknown()),
+			state: model(policy(32), types.StringNull(), types.StringNull()),
+			want:  types.StringUnknown(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := plannedGeneratedAt(tt.plan, tt.state, now)
+			assert.True(t, tt.want.Equal(got), "got %s, want %s", got, tt.want)
+		})
+	}
+
+	t.Run("mechanism changed", func(t *testing.T) {
+		plan := model(policy(32), types.StringNull(), types.StringUnknown())
+		plan.Mechanism = types.StringValue("scram-sha-512")
+		got := plannedGeneratedAt(plan, model(policy(32), types.StringNull(), generatedAt), now)
+		assert.True(t, got.IsUnknown())
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"buf.build/gen/go/redpandadata/dataplane/grpc/go/redpanda/api/dataplane/v1/dataplanev1grpc"
 	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
@@ -37,13 +38,15 @@ var (
 	_ resource.ResourceWithConfigure    = &User{}
 	_ resource.ResourceWithImportState  = &User{}
 	_ resource.ResourceWithUpgradeState = &User{}
+	_ resource.ResourceWithModifyPlan   = &User{}
 )
 
 // User represents the User Terraform resource.
 type User struct {
 	base.ResourceBase
 
-	UserClient dataplanev1grpc.UserServiceClient
+	UserClient   dataplanev1grpc.UserServiceClient
+	SecretClient dataplanev1grpc.SecretServiceClient
 
 	resData config.Resource
 }
@@ -83,6 +86,31 @@ func (*User) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader
 	}
 }
 
+// ModifyPlan plans password_generated_at. Leaving it unknown is what tells
+// Create and Update to generate a new password, so rotate_after turns into an
+// in-place update as soon as it elapses.
+func (*User) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan usermodel.ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	var state *usermodel.ResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &usermodel.ResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := plannedGeneratedAt(&plan, state, time.Now())
+	if planned.Equal(plan.PasswordGeneratedAt) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("password_generated_at"), planned)...)
+}
+
 // Create creates a User resource.
 func (u *User) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model usermodel.ResourceModel
@@ -106,6 +134,17 @@ func (u *User) Create(ctx context.Context, req resource.CreateRequest, resp *res
 		return
 	}
 
+	generatedAt := types.StringNull()
+	if !model.GeneratePassword.IsNull() {
+		password, err := u.newGeneratedPassword(ctx, &model)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to generate password", utils.DeserializeGrpcError(err))
+			return
+		}
+		pbReq.User.Password = password
+		generatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
 	var createdUser usermodel.UserResponse
 	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
 		created, rpcErr := u.UserClient.CreateUser(ctx, pbReq)
@@ -141,6 +180,7 @@ func (u *User) Create(ctx context.Context, req resource.CreateRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	persist.PasswordGeneratedAt = generatedAt
 	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
 }
 
@@ -205,10 +245,14 @@ func (u *User) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 	passwordChanged := !plan.Password.Equal(state.Password)
 	passwordWOVersionChanged := !plan.PasswordWOVersion.Equal(state.PasswordWOVersion)
 	mechanismChanged := !plan.Mechanism.Equal(state.Mechanism)
+	regenerate := !plan.GeneratePassword.IsNull() && plan.PasswordGeneratedAt.IsUnknown()
 
-	if !passwordChanged && !passwordWOVersionChanged && !mechanismChanged {
+	if !passwordChanged && !passwordWOVersionChanged && !mechanismChanged && !regenerate {
 		state.AllowDeletion = plan.AllowDeletion
 		state.PasswordWOVersion = plan.PasswordWOVersion
+		state.GeneratePassword = plan.GeneratePassword
+		state.RotateAfter = plan.RotateAfter
+		state.PasswordGeneratedAt = plan.PasswordGeneratedAt
 		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 		return
 	}
@@ -224,6 +268,17 @@ func (u *User) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 		return
 	}
 
+	generatedAt := plan.PasswordGeneratedAt
+	if regenerate {
+		password, err := u.newGeneratedPassword(ctx, &plan)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to rotate generated password", utils.DeserializeGrpcError(err))
+			return
+		}
+		pbReq.User.Password = password
+		generatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
+
 	var updateResp *dataplanev1.UpdateUserResponse
 	err := utils.Retry(ctx, utils.DefaultDataplaneRetryTimeout, func() *utils.RetryError {
 		var rpcErr error
@@ -246,6 +301,7 @@ func (u *User) Update(ctx context.Context, req resource.UpdateRequest, resp *res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	persist.PasswordGeneratedAt = generatedAt
 	resp.Diagnostics.Append(resp.State.Set(ctx, persist)...)
 }
 
@@ -348,3 +404,15 @@ func (u *User) createUserClient(ctx context.Context, clusterURL string) error {
 	u.UserClient = client
 	return nil
 }
+
+func (u *User) createSecretClient(ctx context.Context, clusterURL string) error {
+	if u.SecretClient != nil {
+		return nil
+	}
+	client, err := utils.NewDataplaneClient(ctx, u.resData.DataplaneConnPool, clusterURL, utils.CapabilityAware(clusterURL, u.CpCl, dataplanev1grpc.NewSecretServiceClient))
+	if err != nil {
+		return err
+	}
+	u.SecretClient = client
+	return nil
+}
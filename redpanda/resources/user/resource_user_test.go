@@ -2,7 +2,9 @@ package user
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
+	"time"
 
 	dataplanev1 "buf.build/gen/go/redpandadata/dataplane/protocolbuffers/go/redpanda/api/dataplane/v1"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -51,6 +53,7 @@ func TestUnit_User_Create_WriteOnlyPassword(t *testing.T) {
 
 	// Plan has password_wo as null (simulates real Terraform behavior for write-only attrs)
 	plan := usermodel.ResourceModel{
+		GeneratePassword:  types.ObjectNull(usermodel.GeneratePasswordAttrTypes()),
 		Name:              types.StringValue("testuser"),
 		ClusterAPIURL:     types.StringValue("http://localhost:9644"),
 		Mechanism:         types.StringValue("scram-sha-256"),
@@ -60,6 +63,7 @@ func TestUnit_User_Create_WriteOnlyPassword(t *testing.T) {
 
 	// Config has the actual password_wo value
 	cfg := usermodel.ResourceModel{
+		GeneratePassword:  types.ObjectNull(usermodel.GeneratePasswordAttrTypes()),
 		Name:              types.StringValue("testuser"),
 		ClusterAPIURL:     types.StringValue("http://localhost:9644"),
 		Mechanism:         types.StringValue("scram-sha-256"),
@@ -88,6 +92,79 @@ func TestUnit_User_Create_WriteOnlyPassword(t *testing.T) {
 	assert.Equal(t, "testuser", state.Name.ValueString())
 }
 
+func TestUnit_User_Create_GeneratedPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	userClient := mocks.NewMockUserServiceClient(ctrl)
+	secretClient := mocks.NewMockSecretServiceClient(ctrl)
+
+	existing := &dataplanev1.Secret{
+		Id:     "APP_PASSWORD",
+		Scopes: []dataplanev1.Scope{dataplanev1.Scope_SCOPE_REDPANDA_CONNECT},
+		Labels: map[string]string{"team": "payments"},
+	}
+	var stored string
+	gomock.InOrder(
+		secretClient.EXPECT().
+			GetSecret(ctx, gomock.Any()).
+			Return(&dataplanev1.GetSecretResponse{Secret: existing}, nil),
+		secretClient.EXPECT().
+			UpdateSecret(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *dataplanev1.UpdateSecretRequest, _ ...any) (*dataplanev1.UpdateSecretResponse, error) {
+				assert.Equal(t, "APP_PASSWORD", req.Id)
+				assert.Equal(t, existing.Scopes, req.Scopes, "scopes must be preserved")
+				assert.Equal(t, existing.Labels, req.Labels, "labels must be preserved")
+				decoded, err := base64.StdEncoding.DecodeString(string(req.SecretData))
+				require.NoError(t, err)
+				stored = string(decoded)
+				return &dataplanev1.UpdateSecretResponse{Secret: existing}, nil
+			}),
+		userClient.EXPECT().
+			CreateUser(ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *dataplanev1.CreateUserRequest, _ ...any) (*dataplanev1.CreateUserResponse, error) {
+				assert.Len(t, req.User.Password, 24)
+				assert.Equal(t, stored, req.User.Password, "the user must get the password written to the secret")
+				return &dataplanev1.CreateUserResponse{
+					User: &dataplanev1.CreateUserResponse_User{Name: req.User.Name},
+				}, nil
+			}),
+	)
+
+	u := &User{UserClient: userClient, SecretClient: secretClient}
+	s := ResourceUserSchema(ctx)
+
+	generate, diags := usermodel.GeneratePasswordToObject(ctx, &usermodel.GeneratePasswordModel{
+		Length:     types.Int64Value(24),
+		Special:    types.BoolValue(false),
+		SecretName: types.StringValue("APP_PASSWORD"),
+	})
+	require.False(t, diags.HasError())
+	plan := usermodel.ResourceModel{
+		Name:                types.StringValue("app"),
+		ClusterAPIURL:       types.StringValue("http://localhost:9644"),
+		Mechanism:           types.StringValue("scram-sha-256"),
+		GeneratePassword:    generate,
+		PasswordGeneratedAt: types.StringUnknown(),
+	}
+
+	req := resource.CreateRequest{Plan: tfsdk.Plan{Schema: s}}
+	require.False(t, req.Plan.Set(ctx, &plan).HasError())
+	req.Config, diags = setConfig(ctx, s, &plan)
+	require.False(t, diags.HasError())
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: s}}
+	u.Create(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "Create should not error: %v", resp.Diagnostics)
+
+	var state usermodel.ResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.True(t, state.Password.IsNull(), "the generated password must not be stored in state")
+	_, err := time.Parse(time.RFC3339, state.PasswordGeneratedAt.ValueString())
+	assert.NoError(t, err, "password_generated_at should be an RFC 3339 timestamp")
+}
+
 func TestUnit_User_Update_WriteOnlyPassword(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -115,6 +192,7 @@ func TestUnit_User_Update_WriteOnlyPassword(t *testing.T) {
 
 	// Plan has password_wo as null (simulates real Terraform behavior)
 	planModel := usermodel.ResourceModel{
+		GeneratePassword:  types.ObjectNull(usermodel.GeneratePasswordAttrTypes()),
 		Name:              types.StringValue("testuser"),
 		ClusterAPIURL:     types.StringValue("http://localhost:9644"),
 		Mechanism:         types.StringValue("scram-sha-256"),
@@ -124,6 +202,7 @@ func TestUnit_User_Update_WriteOnlyPassword(t *testing.T) {
 
 	// State has the previous version
 	stateModel := usermodel.ResourceModel{
+		GeneratePassword:  types.ObjectNull(usermodel.GeneratePasswordAttrTypes()),
 		Name:              types.StringValue("testuser"),
 		ID:                types.StringValue("testuser"),
 		ClusterAPIURL:     types.StringValue("http://localhost:9644"),
@@ -133,6 +212,7 @@ func TestUnit_User_Update_WriteOnlyPassword(t *testing.T) {
 
 	// Config has the actual password_wo value
 	cfgModel := usermodel.ResourceModel{
+		GeneratePassword:  types.ObjectNull(usermodel.GeneratePasswordAttrTypes()),
 		Name:              types.StringValue("testuser"),
 		ClusterAPIURL:     types.StringValue("http://localhost:9644"),
 		Mechanism:         types.StringValue("scram-sha-256"),
@@ -172,8 +252,9 @@ func TestUnit_User_UpgradeState_NormalizesClusterAPIURL(t *testing.T) {
 
 	prior := tfsdk.State{Schema: *up.PriorSchema}
 	require.False(t, prior.Set(ctx, &usermodel.ResourceModel{
-		Name:          types.StringValue("app"),
-		ClusterAPIURL: types.StringValue("api-abc.cid.byoc.prd.cloud.redpanda.com:443"),
+		GeneratePassword: types.ObjectNull(usermodel.GeneratePasswordAttrTypes()),
+		Name:             types.StringValue("app"),
+		ClusterAPIURL:    types.StringValue("api-abc.cid.byoc.prd.cloud.redpanda.com:443"),
 	}).HasError())
 
 	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: ResourceUserSchema(ctx)}}
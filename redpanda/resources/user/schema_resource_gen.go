@@ -19,10 +19,12 @@ package user
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -51,7 +53,7 @@ func ResourceUserSchema(_ context.Context) schema.Schema {
 				Optional:           true,
 				Sensitive:          true,
 				DeprecationMessage: "Use password_wo instead to avoid storing password in Terraform state",
-				Validators:         []validator.String{validators.Password(path.MatchRoot("password"), path.MatchRoot("password_wo"))},
+				Validators:         []validator.String{validators.Password(path.MatchRoot("password"), path.MatchRoot("password_wo")).WithGenerated(path.MatchRoot("generate_password"))},
 			},
 
 			"password_wo": schema.StringAttribute{
@@ -80,6 +82,41 @@ func ResourceUserSchema(_ context.Context) schema.Schema {
 				Validators:    []validator.String{stringvalidator.OneOf("", "scram-sha-256", "scram-sha-512")},
 			},
 
+			"generate_password": schema.SingleNestedAttribute{
+				Description: "Have the provider generate the password instead of supplying password or password_wo. The generated value is never stored in state; it is written to the redpanda_secret named by secret_name.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"length": schema.Int64Attribute{
+						Description: "Length of the generated password, between 16 and 128. Defaults to 32.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(32),
+						Validators:  []validator.Int64{int64validator.Between(16, 128)},
+					},
+					"secret_name": schema.StringAttribute{
+						Description: "Name of an existing redpanda_secret on the same cluster. Every generated password is written to it before the user is created or updated.",
+						Required:    true,
+					},
+					"special": schema.BoolAttribute{
+						Description: "Whether the generated password includes special characters. Defaults to true.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+			},
+
+			"rotate_after": schema.StringAttribute{
+				Description: "Rotate the generated password once this duration has passed since it was last generated, e.g. `720h`. Rotation is planned as an in-place update. Requires generate_password.",
+				Optional:    true,
+				Validators:  []validator.String{validators.Duration(), validators.AlsoRequiresOneOf(path.MatchRoot("generate_password"))},
+			},
+
+			"password_generated_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp of the last password generated by the provider. Null unless generate_password is set.",
+				Computed:    true,
+			},
+
 			"id": schema.StringAttribute{
 				Description:   "Unique identifier of the resource.",
 				Computed:      true,
@@ -0,0 +1,127 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// ServerlessResolver looks up the serverless cluster behind a dataplane API
+// URL; *cloud.ControlPlaneClientSet implements it. A nil cluster and nil error
+// means the URL does not belong to a serverless cluster.
+type ServerlessResolver interface {
+	ServerlessClusterForAPIURL(ctx context.Context, apiURL string) (*controlplanev1.ServerlessCluster, error)
+}
+
+// capabilityNames names the dataplane services that not every cluster
+// implements, keyed by fully-qualified gRPC service name.
+var capabilityNames = map[string]string{
+	"redpanda.api.console.v1alpha1.SecurityService": "Roles and role assignments",
+	"redpanda.api.dataplane.v1.SecretService":       "Secrets",
+	"redpanda.api.dataplane.v1.PipelineService":     "Redpanda Connect pipelines",
+}
+
+// IsUnimplemented checks if the error is a gRPC Unimplemented status, which
+// the dataplane returns for services the cluster does not serve.
+func IsUnimplemented(err error) bool {
+	if err == nil {
+		return false
+	}
+	e, ok := grpcstatus.FromError(err)
+	return ok && e.Code() == grpccodes.Unimplemented
+}
+
+// SecurityServiceURL returns the endpoint serving the console SecurityService
+// for a cluster API URL. Dedicated and BYOC clusters serve it from the console
+// host next to the dataplane API (see ConvertToConsoleURL); serverless
+// clusters serve it from their console URL, or from the dataplane API itself
+// when the cluster reports no console URL.
+func SecurityServiceURL(ctx context.Context, resolver ServerlessResolver, clusterURL string) string {
+	if resolver != nil {
+		if sl, err := resolver.ServerlessClusterForAPIURL(ctx, clusterURL); err == nil && sl != nil {
+			if consoleURL := sl.GetConsoleUrl(); consoleURL != "" {
+				return consoleURL
+			}
+			return clusterURL
+		}
+	}
+	return ConvertToConsoleURL(clusterURL)
+}
+
+// CapabilityAware wraps build so that the client it returns reports calls the
+// cluster does not implement with a message naming the missing capability and
+// whether the cluster is serverless, instead of a bare "unknown service"
+// Unimplemented status. Use it with NewDataplaneClient for services that are
+// not available on every cluster tier.
+func CapabilityAware[T any](clusterURL string, resolver ServerlessResolver, build func(grpc.ClientConnInterface) T) func(grpc.ClientConnInterface) T {
+	return func(conn grpc.ClientConnInterface) T {
+		return build(&capabilityConn{ClientConnInterface: conn, clusterURL: clusterURL, resolver: resolver})
+	}
+}
+
+// capabilityConn rewrites Unimplemented errors returned by the wrapped
+// connection; everything else passes through unchanged.
+type capabilityConn struct {
+	grpc.ClientConnInterface
+	clusterURL string
+	resolver   ServerlessResolver
+}
+
+// Invoke performs a unary RPC and rewrites an Unimplemented error.
+func (c *capabilityConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+	if !IsUnimplemented(err) {
+		return err
+	}
+	return grpcstatus.Error(grpccodes.Unimplemented, c.describe(ctx, method))
+}
+
+// NewStream opens a streaming RPC and rewrites an Unimplemented error.
+func (c *capabilityConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	stream, err := c.ClientConnInterface.NewStream(ctx, desc, method, opts...)
+	if !IsUnimplemented(err) {
+		return stream, err
+	}
+	return nil, grpcstatus.Error(grpccodes.Unimplemented, c.describe(ctx, method))
+}
+
+// describe words the diagnostic for an unimplemented method such as
+// "/redpanda.api.dataplane.v1.SecretService/CreateSecret".
+func (c *capabilityConn) describe(ctx context.Context, method string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	capability, ok := capabilityNames[service]
+	if !ok {
+		capability = "Calls to " + service
+	}
+
+	var sl *controlplanev1.ServerlessCluster
+	if c.resolver != nil {
+		sl, _ = c.resolver.ServerlessClusterForAPIURL(ctx, c.clusterURL)
+	}
+	if sl != nil {
+		return fmt.Sprintf("%s are not available on serverless cluster %q (%s): its dataplane API does not implement %s. Check that the cluster's serverless tier supports this feature.",
+			capability, sl.GetName(), sl.GetId(), service)
+	}
+	return fmt.Sprintf("%s are not available on the cluster at %s: its dataplane API does not implement %s. The cluster may run a Redpanda version that predates this feature.",
+		capability, c.clusterURL, service)
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	controlplanev1 "buf.build/gen/go/redpandadata/cloud/protocolbuffers/go/redpanda/api/controlplane/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// fakeConn returns err from every call.
+type fakeConn struct {
+	err error
+}
+
+func (c fakeConn) Invoke(context.Context, string, any, any, ...grpc.CallOption) error {
+	return c.err
+}
+
+func (c fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, c.err
+}
+
+// fakeResolver resolves every URL to cluster.
+type fakeResolver struct {
+	cluster *controlplanev1.ServerlessCluster
+	calls   int
+}
+
+func (r *fakeResolver) ServerlessClusterForAPIURL(context.Context, string) (*controlplanev1.ServerlessCluster, error) {
+	r.calls++
+	return r.cluster, nil
+}
+
+const testClusterURL = "https://api-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com"
+
+func TestCapabilityAware(t *testing.T) {
+	serverless := &controlplanev1.ServerlessCluster{Id: "cq1k5aq2e1l3jv3vkc5g", Name: "dev"}
+	unimplemented := grpcstatus.Error(codes.Unimplemented, "unknown service redpanda.api.dataplane.v1.SecretService")
+
+	tests := []struct {
+		name        string
+		method      string
+		err         error
+		cluster     *controlplanev1.ServerlessCluster
+		wantCode    codes.Code
+		wantSubstr  []string
+		wantLookups int
+	}{
+		{
+			name:     "success passes through",
+			method:   "/redpanda.api.dataplane.v1.SecretService/CreateSecret",
+			wantCode: codes.OK,
+		},
+		{
+			name:       "other errors pass through",
+			method:     "/redpanda.api.dataplane.v1.SecretService/CreateSecret",
+			err:        grpcstatus.Error(codes.PermissionDenied, "denied"),
+			wantCode:   codes.PermissionDenied,
+			wantSubstr: []string{"denied"},
+		},
+		{
+			name:        "unimplemented on serverless",
+			method:      "/redpanda.api.dataplane.v1.SecretService/CreateSecret",
+			err:         unimplemented,
+			cluster:     serverless,
+			wantCode:    codes.Unimplemented,
+			wantSubstr:  []string{"Secrets are not available", `serverless cluster "dev"`, "cq1k5aq2e1l3jv3vkc5g", "serverless tier"},
+			wantLookups: 1,
+		},
+		{
+			name:        "unimplemented on dedicated",
+			method:      "/redpanda.api.console.v1alpha1.SecurityService/CreateRole",
+			err:         unimplemented,
+			wantCode:    codes.Unimplemented,
+			wantSubstr:  []string{"Roles and role assignments are not available", testClusterURL, "Redpanda version"},
+			wantLookups: 1,
+		},
+		{
+			name:        "unknown service",
+			method:      "/redpanda.api.dataplane.v1.MCPServerService/ListMCPServers",
+			err:         unimplemented,
+			wantCode:    codes.Unimplemented,
+			wantSubstr:  []string{"Calls to redpanda.api.dataplane.v1.MCPServerService are not available"},
+			wantLookups: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver := &fakeResolver{cluster: tt.cluster}
+			conn := CapabilityAware(testClusterURL, resolver, func(c grpc.ClientConnInterface) grpc.ClientConnInterface { return c })(fakeConn{err: tt.err})
+
+			invokeErr := conn.Invoke(context.Background(), tt.method, nil, nil)
+			_, streamErr := conn.NewStream(context.Background(), &grpc.StreamDesc{}, tt.method)
+			for _, err := range []error{invokeErr, streamErr} {
+				if got := grpcstatus.Code(err); got != tt.wantCode {
+					t.Fatalf("code = %v, want %v (err=%v)", got, tt.wantCode, err)
+				}
+				for _, s := range tt.wantSubstr {
+					if !strings.Contains(DeserializeGrpcError(err), s) {
+						t.Errorf("error must contain %q; got %v", s, err)
+					}
+				}
+			}
+			if resolver.calls != 2*tt.wantLookups {
+				t.Errorf("resolver called %d times, want %d", resolver.calls, 2*tt.wantLookups)
+			}
+		})
+	}
+}
+
+func TestSecurityServiceURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		resolver ServerlessResolver
+		want     string
+	}{
+		{
+			name: "no resolver uses the console host",
+			want: "https://console-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com",
+		},
+		{
+			name:     "dedicated cluster uses the console host",
+			resolver: &fakeResolver{},
+			want:     "https://console-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com",
+		},
+		{
+			name:     "serverless cluster uses its console URL",
+			resolver: &fakeResolver{cluster: &controlplanev1.ServerlessCluster{ConsoleUrl: "https://console.serverless.example.com"}},
+			want:     "https://console.serverless.example.com",
+		},
+		{
+			name:     "serverless cluster without console URL uses the dataplane API",
+			resolver: &fakeResolver{cluster: &controlplanev1.ServerlessCluster{}},
+			want:     testClusterURL,
+		},
+		{
+			name:     "lookup failure falls back to the console host",
+			resolver: errResolver{},
+			want:     "https://console-a1b2c3d4.cq1k5aq2e1l3jv3vkc5g.fmc.prd.cloud.redpanda.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SecurityServiceURL(context.Background(), tt.resolver, testClusterURL); got != tt.want {
+				t.Errorf("SecurityServiceURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+type errResolver struct{}
+
+func (errResolver) ServerlessClusterForAPIURL(context.Context, string) (*controlplanev1.ServerlessCluster, error) {
+	return nil, errors.New("boom")
+}
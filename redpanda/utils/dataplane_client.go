@@ -28,7 +28,9 @@ import (
 // dataplane/console service client via build (e.g. dataplanev1grpc.NewUserServiceClient).
 // It applies the guards every per-resource client constructor needs — empty URL
 // and unconfigured pool — and wraps connection failures with the deserialized
-// gRPC message, so all resources surface these the same way.
+// gRPC message, so all resources surface these the same way. Full listings such
+// as ListTopics are shared with other resources on the same cluster through
+// the pool's ListCache.
 func NewDataplaneClient[T any](ctx context.Context, pool *cloud.ConnPool, clusterURL string, build func(grpc.ClientConnInterface) T) (T, error) {
 	var zero T
 	if clusterURL == "" {
@@ -37,7 +39,7 @@ func NewDataplaneClient[T any](ctx context.Context, pool *cloud.ConnPool, cluste
 	if pool == nil {
 		return zero, errors.New("provider not configured: dataplane connection pool is nil")
 	}
-	conn, err := pool.GetConnection(ctx, clusterURL)
+	conn, err := pool.GetCachedConnection(ctx, clusterURL)
 	if err != nil {
 		return zero, fmt.Errorf("unable to open a connection with the cluster API: %v", DeserializeGrpcError(err))
 	}
@@ -0,0 +1,75 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SchemaRegistryContextPattern matches a Schema Registry context name as
+// configured in Terraform: the name without its leading dot or any colons.
+var SchemaRegistryContextPattern = regexp.MustCompile(`^[^.:][^:]*$`)
+
+// QualifySubject returns subject in the `:.context:subject` form Schema
+// Registry uses to address subjects outside the default context. An empty
+// context, or a subject that is already qualified, is returned unchanged.
+func QualifySubject(ctxName, subject string) string {
+	if ctxName == "" || strings.HasPrefix(subject, ":.") {
+		return subject
+	}
+	return ":." + ctxName + ":" + subject
+}
+
+// SplitQualifiedSubject splits a `:.context:subject` name into its context and
+// subject. Unqualified names are returned with an empty context.
+func SplitQualifiedSubject(name string) (ctxName, subject string) {
+	rest, ok := strings.CutPrefix(name, ":.")
+	if !ok {
+		return "", name
+	}
+	ctxName, subject, ok = strings.Cut(rest, ":")
+	if !ok || ctxName == "" {
+		return "", name
+	}
+	return ctxName, subject
+}
+
+// SameSubject reports whether a and b address the same subject when
+// unqualified names are read relative to the context ctxName.
+func SameSubject(ctxName, a, b string) bool {
+	return QualifySubject(ctxName, a) == QualifySubject(ctxName, b)
+}
+
+// Values accepted by the auth_mode attribute of Schema Registry resources.
+const (
+	SchemaRegistryAuthModeProviderToken = "provider_token"
+	SchemaRegistryAuthModeBasic         = "basic"
+)
+
+// SchemaRegistryCredentials returns the username and password a Schema
+// Registry client should use for authMode. In provider_token mode none are
+// returned, so the client authenticates with the provider's cloud token even
+// if SASL credentials linger in state. A null auth_mode keeps the legacy
+// behaviour: Basic auth when both credentials are present.
+func SchemaRegistryCredentials(authMode types.String, username, password string) (string, string) {
+	if authMode.ValueString() == SchemaRegistryAuthModeProviderToken {
+		return "", ""
+	}
+	return username, password
+}
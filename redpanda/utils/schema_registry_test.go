@@ -0,0 +1,78 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualifySubject(t *testing.T) {
+	assert.Equal(t, "orders-value", QualifySubject("", "orders-value"))
+	assert.Equal(t, ":.tenant-a:orders-value", QualifySubject("tenant-a", "orders-value"))
+	assert.Equal(t, ":.tenant-b:orders-value", QualifySubject("tenant-a", ":.tenant-b:orders-value"),
+		"an explicitly qualified subject keeps its own context")
+}
+
+func TestSplitQualifiedSubject(t *testing.T) {
+	tests := []struct {
+		in          string
+		wantContext string
+		wantSubject string
+	}{
+		{in: "orders-value", wantSubject: "orders-value"},
+		{in: ":.tenant-a:orders-value", wantContext: "tenant-a", wantSubject: "orders-value"},
+		{in: ":.tenant-a:ns:with:colons", wantContext: "tenant-a", wantSubject: "ns:with:colons"},
+		{in: ":.:orders-value", wantSubject: ":.:orders-value"},
+		{in: ":.tenant-a", wantSubject: ":.tenant-a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			ctx, subject := SplitQualifiedSubject(tt.in)
+			assert.Equal(t, tt.wantContext, ctx)
+			assert.Equal(t, tt.wantSubject, subject)
+		})
+	}
+}
+
+func TestSameSubject(t *testing.T) {
+	assert.True(t, SameSubject("tenant-a", "common", ":.tenant-a:common"))
+	assert.False(t, SameSubject("tenant-a", "common", ":.tenant-b:common"))
+	assert.True(t, SameSubject("", "common", "common"))
+}
+
+func TestSchemaRegistryContextPattern(t *testing.T) {
+	assert.True(t, SchemaRegistryContextPattern.MatchString("tenant-a"))
+	assert.False(t, SchemaRegistryContextPattern.MatchString(".tenant-a"))
+	assert.False(t, SchemaRegistryContextPattern.MatchString("tenant:a"))
+	assert.False(t, SchemaRegistryContextPattern.MatchString(""))
+}
+
+func TestSchemaRegistryCredentials(t *testing.T) {
+	user, pass := SchemaRegistryCredentials(types.StringValue(SchemaRegistryAuthModeProviderToken), "alice", "secret")
+	assert.Empty(t, user)
+	assert.Empty(t, pass)
+
+	user, pass = SchemaRegistryCredentials(types.StringValue(SchemaRegistryAuthModeBasic), "alice", "secret")
+	assert.Equal(t, "alice", user)
+	assert.Equal(t, "secret", pass)
+
+	user, pass = SchemaRegistryCredentials(types.StringNull(), "alice", "secret")
+	assert.Equal(t, "alice", user, "unset auth_mode keeps inferring Basic auth from the credentials")
+	assert.Equal(t, "secret", pass)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+// Package topicconfig holds the catalog of Redpanda topic properties the
+// provider knows about. It drives plan-time validation of
+// redpanda_topic.configuration and normalises human-friendly units (`7d`,
+// `1GiB`) into the raw values the broker expects.
+package topicconfig
+
+import (
+	"sort"
+	"strings"
+)
+
+// Kind is the value type of a topic property.
+type Kind int
+
+const (
+	// KindString accepts any value.
+	KindString Kind = iota
+	// KindBool accepts `true` or `false`.
+	KindBool
+	// KindInt accepts a whole number.
+	KindInt
+	// KindDouble accepts a decimal number.
+	KindDouble
+	// KindEnum accepts one of Property.Allowed.
+	KindEnum
+	// KindEnumList accepts a comma-separated list of Property.Allowed.
+	KindEnumList
+	// KindDuration accepts milliseconds or a duration with a unit suffix
+	// (ms, s, m, h, d, w), normalised to milliseconds.
+	KindDuration
+	// KindBytes accepts bytes or a size with a unit suffix (B, KiB, MiB,
+	// GiB, TiB), normalised to bytes.
+	KindBytes
+	// KindPartitionSpec accepts an Iceberg partition spec such as
+	// `(hour(redpanda.timestamp))`.
+	KindPartitionSpec
+)
+
+// String returns the name used for the kind in diagnostics.
+func (k Kind) String() string {
+	switch k {
+	case KindBool:
+		return "boolean"
+	case KindInt:
+		return "integer"
+	case KindDouble:
+		return "number"
+	case KindEnum, KindEnumList:
+		return "enum"
+	case KindDuration:
+		return "duration"
+	case KindBytes:
+		return "size"
+	case KindPartitionSpec:
+		return "partition spec"
+	default:
+		return "string"
+	}
+}
+
+// Property describes one topic property.
+type Property struct {
+	Name string
+	Kind Kind
+	// Allowed lists the accepted values for KindEnum and KindEnumList.
+	Allowed []string
+	// AllowsOptions lets a KindEnum value carry `:`-separated options after
+	// the enum value, e.g. `value_schema_latest:subject=orders`.
+	AllowsOptions bool
+	// Min and Max bound numeric kinds, after unit normalisation. Nil means
+	// unbounded.
+	Min, Max *float64
+	// ReadOnly properties can only be set when the topic is created; Redpanda
+	// rejects changes to them afterwards.
+	ReadOnly bool
+}
+
+func bound(v float64) *float64 { return &v }
+
+var subjectNameStrategies = []string{"TopicNameStrategy", "RecordNameStrategy", "TopicRecordNameStrategy"}
+
+var confluentSubjectNameStrategies = []string{
+	"io.confluent.kafka.serializers.subject.TopicNameStrategy",
+	"io.confluent.kafka.serializers.subject.RecordNameStrategy",
+	"io.confluent.kafka.serializers.subject.TopicRecordNameStrategy",
+}
+
+var catalog = map[string]Property{}
+
+func init() {
+	for _, p := range []Property{
+		{Name: "cleanup.policy", Kind: KindEnumList, Allowed: []string{"delete", "compact"}},
+		{Name: "compression.type", Kind: KindEnum, Allowed: []string{"producer", "none", "uncompressed", "gzip", "snappy", "lz4", "zstd"}},
+		{Name: "confluent.key.schema.validation", Kind: KindBool},
+		{Name: "confluent.key.subject.name.strategy", Kind: KindEnum, Allowed: confluentSubjectNameStrategies},
+		{Name: "confluent.value.schema.validation", Kind: KindBool},
+		{Name: "confluent.value.subject.name.strategy", Kind: KindEnum, Allowed: confluentSubjectNameStrategies},
+		{Name: "delete.retention.ms", Kind: KindDuration, Min: bound(-1)},
+		{Name: "flush.bytes", Kind: KindBytes, Min: bound(1)},
+		{Name: "flush.ms", Kind: KindDuration, Min: bound(0)},
+		{Name: "initial.retention.local.target.bytes", Kind: KindBytes, Min: bound(-1)},
+		{Name: "initial.retention.local.target.ms", Kind: KindDuration, Min: bound(-1)},
+		{Name: "max.compaction.lag.ms", Kind: KindDuration, Min: bound(1)},
+		{Name: "max.message.bytes", Kind: KindBytes, Min: bound(1)},
+		{Name: "message.timestamp.after.max.ms", Kind: KindDuration, Min: bound(0)},
+		{Name: "message.timestamp.before.max.ms", Kind: KindDuration, Min: bound(0)},
+		{Name: "message.timestamp.type", Kind: KindEnum, Allowed: []string{"CreateTime", "LogAppendTime"}},
+		{Name: "min.cleanable.dirty.ratio", Kind: KindDouble, Min: bound(0), Max: bound(1)},
+		{Name: "min.compaction.lag.ms", Kind: KindDuration, Min: bound(0)},
+		{Name: "min.insync.replicas", Kind: KindInt, Min: bound(1)},
+		{Name: "redpanda.iceberg.delete", Kind: KindBool},
+		{Name: "redpanda.iceberg.invalid.record.action", Kind: KindEnum, Allowed: []string{"drop", "dlq_table"}},
+		{Name: "redpanda.iceberg.mode", Kind: KindEnum, Allowed: []string{"disabled", "key_value", "value_schema_id_prefix", "value_schema_latest"}, AllowsOptions: true},
+		{Name: "redpanda.iceberg.partition.spec", Kind: KindPartitionSpec},
+		{Name: "redpanda.iceberg.target.lag.ms", Kind: KindDuration, Min: bound(0)},
+		{Name: "redpanda.key.schema.id.validation", Kind: KindBool},
+		{Name: "redpanda.key.subject.name.strategy", Kind: KindEnum, Allowed: subjectNameStrategies},
+		{Name: "redpanda.leaders.preference", Kind: KindString},
+		{Name: "redpanda.remote.delete", Kind: KindBool},
+		{Name: "redpanda.remote.read", Kind: KindBool},
+		{Name: "redpanda.remote.readreplica", Kind: KindString, ReadOnly: true},
+		{Name: "redpanda.remote.recovery", Kind: KindBool, ReadOnly: true},
+		{Name: "redpanda.remote.write", Kind: KindBool},
+		{Name: "redpanda.storage.mode", Kind: KindEnum, Allowed: []string{"local", "tiered", "cloud", "unset"}},
+		{Name: "redpanda.value.schema.id.validation", Kind: KindBool},
+		{Name: "redpanda.value.subject.name.strategy", Kind: KindEnum, Allowed: subjectNameStrategies},
+		{Name: "replication.factor", Kind: KindInt, Min: bound(1)},
+		{Name: "retention.bytes", Kind: KindBytes, Min: bound(-1)},
+		{Name: "retention.local.target.bytes", Kind: KindBytes, Min: bound(-1)},
+		{Name: "retention.local.target.ms", Kind: KindDuration, Min: bound(-1)},
+		{Name: "retention.ms", Kind: KindDuration, Min: bound(-1)},
+		{Name: "segment.bytes", Kind: KindBytes, Min: bound(1)},
+		{Name: "segment.ms", Kind: KindDuration},
+		{Name: "write.caching", Kind: KindEnum, Allowed: []string{"true", "false"}},
+	} {
+		catalog[p.Name] = p
+	}
+}
+
+// Lookup returns the catalog entry for a property name.
+func Lookup(name string) (Property, bool) {
+	p, ok := catalog[name]
+	return p, ok
+}
+
+// Names returns every property name in the catalog, sorted.
+func Names() []string {
+	names := make([]string, 0, len(catalog))
+	for name := range catalog {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Suggest returns the candidate closest to s, or "" when none is close enough
+// to be a likely typo. Matching ignores case so `createtime` still suggests
+// `CreateTime`.
+func Suggest(s string, candidates []string) string {
+	best, bestDist := "", -1
+	for _, c := range candidates {
+		d := editDistance(strings.ToLower(s), strings.ToLower(c))
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	if bestDist < 0 || bestDist > max(2, len(s)/4) {
+		return ""
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topicconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"retention.msg", "retention.ms"},
+		{"retention_ms", "retention.ms"},
+		{"segment.byte", "segment.bytes"},
+		{"cleanup.polcy", "cleanup.policy"},
+		{"redpanda.remote.reed", "redpanda.remote.read"},
+		{"something.else.entirely", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, Suggest(tt.input, Names()))
+		})
+	}
+	assert.Equal(t, "compact", Suggest("compactt", []string{"delete", "compact"}))
+	assert.Equal(t, "CreateTime", Suggest("createtime", []string{"CreateTime", "LogAppendTime"}))
+}
+
+func TestCatalogEntriesAreConsistent(t *testing.T) {
+	for _, name := range Names() {
+		p, ok := Lookup(name)
+		assert.True(t, ok)
+		assert.Equal(t, name, p.Name)
+		if p.Kind == KindEnum || p.Kind == KindEnumList {
+			assert.NotEmpty(t, p.Allowed, "%s must list its allowed values", name)
+		} else {
+			assert.Empty(t, p.Allowed, "%s is not an enum", name)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topicconfig
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	fieldPattern     = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+	transformPattern = regexp.MustCompile(`^([a-z_]+)\s*\((.*)\)$`)
+
+	// fieldTransforms take a single source field.
+	fieldTransforms = []string{"identity", "year", "month", "day", "hour", "void"}
+	// widthTransforms take a positive width followed by a source field.
+	widthTransforms = []string{"bucket", "truncate"}
+)
+
+// ValidatePartitionSpec checks the syntax of a redpanda.iceberg.partition.spec
+// value: a parenthesised, comma-separated list of source fields, each either
+// bare (`redpanda.partition`) or wrapped in an Iceberg transform
+// (`hour(redpanda.timestamp)`, `bucket(16, user_id)`). `()` leaves the table
+// unpartitioned.
+func ValidatePartitionSpec(spec string) error {
+	s := strings.TrimSpace(spec)
+	if !strings.HasPrefix(s, "(") || !strings.HasSuffix(s, ")") {
+		return fmt.Errorf("%q must be a parenthesised list of fields, e.g. (hour(redpanda.timestamp))", spec)
+	}
+	inner := strings.TrimSpace(s[1 : len(s)-1])
+	if inner == "" {
+		return nil
+	}
+	terms, err := splitTopLevel(inner)
+	if err != nil {
+		return fmt.Errorf("%q: %w", spec, err)
+	}
+	for _, term := range terms {
+		if err := validatePartitionTerm(term); err != nil {
+			return fmt.Errorf("%q: %w", spec, err)
+		}
+	}
+	return nil
+}
+
+func validatePartitionTerm(term string) error {
+	if term == "" {
+		return errors.New("empty partition field")
+	}
+	if fieldPattern.MatchString(term) {
+		return nil
+	}
+	m := transformPattern.FindStringSubmatch(term)
+	if m == nil {
+		return fmt.Errorf("%q is not a field name or a transform such as hour(field)", term)
+	}
+	name := m[1]
+	args, err := splitTopLevel(m[2])
+	if err != nil {
+		return err
+	}
+	switch {
+	case slices.Contains(fieldTransforms, name):
+		if len(args) != 1 || !fieldPattern.MatchString(args[0]) {
+			return fmt.Errorf("%s takes a single field, e.g. %s(redpanda.timestamp)", name, name)
+		}
+	case slices.Contains(widthTransforms, name):
+		if len(args) != 2 || !fieldPattern.MatchString(args[1]) {
+			return fmt.Errorf("%s takes a width and a field, e.g. %s(16, user_id)", name, name)
+		}
+		if n, err := strconv.Atoi(args[0]); err != nil || n <= 0 {
+			return fmt.Errorf("%s width %q must be a positive integer", name, args[0])
+		}
+	default:
+		msg := fmt.Sprintf("unknown transform %q", name)
+		if s := Suggest(name, append(append([]string{}, fieldTransforms...), widthTransforms...)); s != "" {
+			msg += fmt.Sprintf(". Did you mean %q?", s)
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// splitTopLevel splits s on commas that are not nested in parentheses and
+// trims each part.
+func splitTopLevel(s string) ([]string, error) {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced parentheses")
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced parentheses")
+	}
+	return append(parts, strings.TrimSpace(s[start:])), nil
+}
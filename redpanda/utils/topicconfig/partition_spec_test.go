@@ -0,0 +1,56 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topicconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePartitionSpec(t *testing.T) {
+	tests := []struct {
+		spec      string
+		expectErr string
+	}{
+		{"(hour(redpanda.timestamp))", ""},
+		{"()", ""},
+		{"( redpanda.partition , day(redpanda.timestamp) )", ""},
+		{"(bucket(16, user_id), truncate(4, name), identity(region))", ""},
+		{"hour(redpanda.timestamp)", "parenthesised list"},
+		{"(hour(redpanda.timestamp)", "unbalanced parentheses"},
+		{"(hour(redpanda.timestamp), )", "empty partition field"},
+		{"(hours(redpanda.timestamp))", `Did you mean "hour"?`},
+		{"(bucket(user_id))", "takes a width and a field"},
+		{"(bucket(0, user_id))", "must be a positive integer"},
+		{"(hour(a, b))", "takes a single field"},
+		{"(hour(a)))", "unbalanced parentheses"},
+		{"(user-id)", "is not a field name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			err := ValidatePartitionSpec(tt.spec)
+			if tt.expectErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectErr)
+		})
+	}
+	assert.Error(t, Validate("redpanda.iceberg.partition.spec", "hour(ts)"), "the catalog checks partition specs")
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topicconfig
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	durationPattern = regexp.MustCompile(`^(-?\d+)\s*(ms|s|m|h|d|w)?$`)
+	bytesPattern    = regexp.MustCompile(`^(-?\d+)\s*(B|KiB|MiB|GiB|TiB)?$`)
+
+	durationUnits = map[string]int64{"": 1, "ms": 1, "s": 1000, "m": 60 * 1000, "h": 60 * 60 * 1000, "d": 24 * 60 * 60 * 1000, "w": 7 * 24 * 60 * 60 * 1000}
+	bytesUnits    = map[string]int64{"": 1, "B": 1, "KiB": 1 << 10, "MiB": 1 << 20, "GiB": 1 << 30, "TiB": 1 << 40}
+)
+
+// Validate checks value against the property's kind, allowed values and
+// range. Values of properties missing from the catalog are not checked.
+func Validate(name, value string) error {
+	p, ok := Lookup(name)
+	if !ok {
+		return nil
+	}
+	switch p.Kind {
+	case KindBool:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("%q is not a boolean; use true or false", value)
+		}
+	case KindInt:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+		return p.checkRange(float64(n), value)
+	case KindDouble:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a number", value)
+		}
+		return p.checkRange(f, value)
+	case KindEnum:
+		v := value
+		if p.AllowsOptions {
+			v, _, _ = strings.Cut(value, ":")
+		}
+		return p.checkAllowed(v)
+	case KindEnumList:
+		for _, v := range strings.Split(value, ",") {
+			if err := p.checkAllowed(strings.TrimSpace(v)); err != nil {
+				return err
+			}
+		}
+	case KindDuration, KindBytes:
+		n, err := p.parseUnits(value)
+		if err != nil {
+			return err
+		}
+		return p.checkRange(float64(n), value)
+	case KindPartitionSpec:
+		return ValidatePartitionSpec(value)
+	}
+	return nil
+}
+
+// Normalize returns the value Redpanda expects for the property: durations
+// become milliseconds and sizes become bytes. Other values, and properties
+// missing from the catalog, are returned unchanged.
+func Normalize(name, value string) (string, error) {
+	p, ok := Lookup(name)
+	if !ok || (p.Kind != KindDuration && p.Kind != KindBytes) {
+		return value, nil
+	}
+	n, err := p.parseUnits(value)
+	if err != nil {
+		return "", fmt.Errorf("topic configuration %q: %w", name, err)
+	}
+	return strconv.FormatInt(n, 10), nil
+}
+
+// NormalizeMap applies Normalize to every element of a configuration map.
+// Null and unknown maps are returned as-is.
+func NormalizeMap(cfg types.Map) (types.Map, error) {
+	if cfg.IsNull() || cfg.IsUnknown() {
+		return cfg, nil
+	}
+	out := make(map[string]attr.Value, len(cfg.Elements()))
+	for k, v := range cfg.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			out[k] = v
+			continue
+		}
+		n, err := Normalize(k, s.ValueString())
+		if err != nil {
+			return cfg, err
+		}
+		out[k] = types.StringValue(n)
+	}
+	m, diags := types.MapValue(types.StringType, out)
+	if diags.HasError() {
+		return cfg, errors.New("unable to normalize the topic configuration map")
+	}
+	return m, nil
+}
+
+// Equivalent reports whether a configured value and the value the broker
+// reports are the same once units are normalised, e.g. `7d` and `604800000`
+// for retention.ms.
+func Equivalent(name, configured, reported string) bool {
+	if configured == reported {
+		return true
+	}
+	n, err := Normalize(name, configured)
+	return err == nil && n == reported
+}
+
+func (p Property) parseUnits(value string) (int64, error) {
+	pattern, units, unitNames := durationPattern, durationUnits, "ms, s, m, h, d or w"
+	if p.Kind == KindBytes {
+		pattern, units, unitNames = bytesPattern, bytesUnits, "B, KiB, MiB, GiB or TiB"
+	}
+	m := pattern.FindStringSubmatch(strings.TrimSpace(value))
+	if m == nil {
+		return 0, fmt.Errorf("%q is not a valid %s; use a whole number or a number with a unit (%s)", value, p.Kind, unitNames)
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q is out of range", value)
+	}
+	if m[2] == "" {
+		return n, nil
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("%q must not be negative when a unit is given", value)
+	}
+	mult := units[m[2]]
+	if n > math.MaxInt64/mult {
+		return 0, fmt.Errorf("%q is out of range", value)
+	}
+	return n * mult, nil
+}
+
+func (p Property) checkRange(v float64, value string) error {
+	if p.Min != nil && v < *p.Min {
+		return fmt.Errorf("%q must be at least %s", value, strconv.FormatFloat(*p.Min, 'f', -1, 64))
+	}
+	if p.Max != nil && v > *p.Max {
+		return fmt.Errorf("%q must be at most %s", value, strconv.FormatFloat(*p.Max, 'f', -1, 64))
+	}
+	return nil
+}
+
+func (p Property) checkAllowed(v string) error {
+	for _, a := range p.Allowed {
+		if v == a {
+			return nil
+		}
+	}
+	msg := fmt.Sprintf("%q is not one of %s", v, strings.Join(p.Allowed, ", "))
+	if s := Suggest(v, p.Allowed); s != "" {
+		msg += fmt.Sprintf(". Did you mean %q?", s)
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package topicconfig
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		property  string
+		value     string
+		expectErr string
+	}{
+		{"unknown property is not checked", "x.custom", "anything", ""},
+		{"enum", "compression.type", "zstd", ""},
+		{"enum typo", "compression.type", "zstdd", `Did you mean "zstd"?`},
+		{"enum list", "cleanup.policy", "compact,delete", ""},
+		{"enum list typo", "cleanup.policy", "compactt", `Did you mean "compact"?`},
+		{"enum with options", "redpanda.iceberg.mode", "value_schema_latest:subject=orders", ""},
+		{"enum options not allowed", "compression.type", "zstd:level=3", "is not one of"},
+		{"bool", "redpanda.remote.read", "true", ""},
+		{"bool invalid", "redpanda.remote.read", "yes", "not a boolean"},
+		{"int", "min.insync.replicas", "2", ""},
+		{"int below min", "min.insync.replicas", "0", "must be at least 1"},
+		{"double", "min.cleanable.dirty.ratio", "0.5", ""},
+		{"double above max", "min.cleanable.dirty.ratio", "1.5", "must be at most 1"},
+		{"duration raw ms", "retention.ms", "86400000", ""},
+		{"duration infinite", "retention.ms", "-1", ""},
+		{"duration unit", "retention.ms", "7d", ""},
+		{"duration bad unit", "retention.ms", "7y", "not a valid duration"},
+		{"duration negative unit", "retention.ms", "-1d", "must not be negative"},
+		{"duration below min", "retention.ms", "-2", "must be at least -1"},
+		{"bytes unit", "segment.bytes", "1GiB", ""},
+		{"bytes decimal unit rejected", "segment.bytes", "1GB", "not a valid size"},
+		{"bytes below min", "segment.bytes", "0", "must be at least 1"},
+		{"overflow", "retention.ms", "9223372036854775807w", "out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.property, tt.value)
+			if tt.expectErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectErr)
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		property string
+		value    string
+		want     string
+	}{
+		{"retention.ms", "7d", "604800000"},
+		{"retention.ms", "1w", "604800000"},
+		{"retention.ms", "90s", "90000"},
+		{"retention.ms", "-1", "-1"},
+		{"segment.ms", "1h", "3600000"},
+		{"retention.bytes", "1GiB", "1073741824"},
+		{"retention.bytes", "512 MiB", "536870912"},
+		{"cleanup.policy", "compact", "compact"},
+		{"x.custom", "7d", "7d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.property+"="+tt.value, func(t *testing.T) {
+			got, err := Normalize(tt.property, tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := Normalize("retention.ms", "soon")
+	assert.ErrorContains(t, err, `topic configuration "retention.ms"`)
+}
+
+func TestNormalizeMap(t *testing.T) {
+	cfg := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"retention.ms":     types.StringValue("7d"),
+		"cleanup.policy":   types.StringValue("compact"),
+		"compression.type": types.StringUnknown(),
+	})
+	got, err := NormalizeMap(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, types.StringValue("604800000"), got.Elements()["retention.ms"])
+	assert.Equal(t, types.StringValue("compact"), got.Elements()["cleanup.policy"])
+	assert.True(t, got.Elements()["compression.type"].IsUnknown())
+
+	null := types.MapNull(types.StringType)
+	got, err = NormalizeMap(null)
+	require.NoError(t, err)
+	assert.True(t, got.IsNull())
+}
+
+func TestEquivalent(t *testing.T) {
+	assert.True(t, Equivalent("retention.ms", "7d", "604800000"))
+	assert.True(t, Equivalent("retention.ms", "604800000", "604800000"))
+	assert.False(t, Equivalent("retention.ms", "7d", "86400000"))
+	assert.False(t, Equivalent("x.custom", "7d", "604800000"))
+}
@@ -325,20 +325,25 @@ func TrimmedStringValue(s string) types.String {
 	return basetypes.NewStringValue(strings.Trim(s, "\""))
 }
 
-// FindUserByName searches for a user by name using the provided client
+// FindUserByName searches for a user by name using the provided client. It
+// lists every user of the cluster rather than filtering by name, so that the
+// user resources of one cluster issue identical requests and share a single
+// response during refresh (see cloud.ListCache).
 func FindUserByName(ctx context.Context, name string, client dataplanev1grpc.UserServiceClient) (*dataplanev1.ListUsersResponse_User, error) {
-	usrs, err := client.ListUsers(ctx, &dataplanev1.ListUsersRequest{
-		Filter: &dataplanev1.ListUsersRequest_Filter{
-			Name: name,
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	for _, v := range usrs.GetUsers() {
-		if v.GetName() == name {
-			return v, nil
+	var pageToken string
+	for {
+		usrs, err := client.ListUsers(ctx, &dataplanev1.ListUsersRequest{PageToken: pageToken})
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range usrs.GetUsers() {
+			if v.GetName() == name {
+				return v, nil
+			}
+		}
+		pageToken = usrs.GetNextPageToken()
+		if pageToken == "" {
+			break
 		}
 	}
 	return nil, NotFoundError{fmt.Sprintf("user %q not found", name)}
@@ -463,16 +468,14 @@ func NumberToInt32OrNil(n types.Number) *int32 {
 	return NumberToInt32(n)
 }
 
-// FindTopicByName searches for a topic by name using the provided client.
+// FindTopicByName searches for a topic by name using the provided client. It
+// lists every topic of the cluster rather than filtering by name, so that the
+// topic resources of one cluster issue identical requests and share a single
+// response during refresh (see cloud.ListCache).
 func FindTopicByName(ctx context.Context, topicName string, client dataplanev1grpc.TopicServiceClient) (*dataplanev1.ListTopicsResponse_Topic, error) {
 	var pageToken string
 	for {
-		topics, err := client.ListTopics(ctx, &dataplanev1.ListTopicsRequest{
-			Filter: &dataplanev1.ListTopicsRequest_Filter{
-				NameContains: topicName,
-			},
-			PageToken: pageToken,
-		})
+		topics, err := client.ListTopics(ctx, &dataplanev1.ListTopicsRequest{PageToken: pageToken})
 		if err != nil {
 			return nil, err
 		}
@@ -489,6 +492,20 @@ func FindTopicByName(ctx context.Context, topicName string, client dataplanev1gr
 	return nil, NotFoundError{fmt.Sprintf("topic %s not found", topicName)}
 }
 
+// ListAllACLsRequest returns a ListACLs request matching every ACL of the
+// cluster. ACL readers narrow the response client-side, so that all of them
+// issue the same request and share a single response (see cloud.ListCache).
+func ListAllACLsRequest() *dataplanev1.ListACLsRequest {
+	return &dataplanev1.ListACLsRequest{
+		Filter: &dataplanev1.ListACLsRequest_Filter{
+			ResourceType:        dataplanev1.ACL_RESOURCE_TYPE_ANY,
+			ResourcePatternType: dataplanev1.ACL_RESOURCE_PATTERN_TYPE_ANY,
+			Operation:           dataplanev1.ACL_OPERATION_ANY,
+			PermissionType:      dataplanev1.ACL_PERMISSION_TYPE_ANY,
+		},
+	}
+}
+
 // SplitSchemeDefPort splits the schema from the url and return url+port. If
 // there is no port, we use the provided default.
 func SplitSchemeDefPort(url, def string) (string, error) {
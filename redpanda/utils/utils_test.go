@@ -264,11 +264,7 @@ func TestFindUserByName(t *testing.T) {
 		{
 			name: "User found",
 			setupMock: func() {
-				mockClient.EXPECT().ListUsers(gomock.Any(), &dataplanev1.ListUsersRequest{
-					Filter: &dataplanev1.ListUsersRequest_Filter{
-						Name: "alice",
-					},
-				}).Return(&dataplanev1.ListUsersResponse{
+				mockClient.EXPECT().ListUsers(gomock.Any(), &dataplanev1.ListUsersRequest{}).Return(&dataplanev1.ListUsersResponse{
 					Users: []*dataplanev1.ListUsersResponse_User{
 						{Name: "alice"},
 						{Name: "bob"},
@@ -282,11 +278,7 @@ func TestFindUserByName(t *testing.T) {
 		{
 			name: "User not found",
 			setupMock: func() {
-				mockClient.EXPECT().ListUsers(gomock.Any(), &dataplanev1.ListUsersRequest{
-					Filter: &dataplanev1.ListUsersRequest_Filter{
-						Name: "charlie",
-					},
-				}).Return(&dataplanev1.ListUsersResponse{
+				mockClient.EXPECT().ListUsers(gomock.Any(), &dataplanev1.ListUsersRequest{}).Return(&dataplanev1.ListUsersResponse{
 					Users: []*dataplanev1.ListUsersResponse_User{
 						{Name: "alice"},
 						{Name: "bob"},
@@ -297,6 +289,27 @@ func TestFindUserByName(t *testing.T) {
 			expectedUser: nil,
 			expectedErr:  `user "charlie" not found`,
 		},
+		{
+			name: "User found on second page",
+			setupMock: func() {
+				mockClient.EXPECT().ListUsers(gomock.Any(), &dataplanev1.ListUsersRequest{}).Return(&dataplanev1.ListUsersResponse{
+					Users: []*dataplanev1.ListUsersResponse_User{
+						{Name: "alice"},
+					},
+					NextPageToken: "page2",
+				}, nil)
+				mockClient.EXPECT().ListUsers(gomock.Any(), &dataplanev1.ListUsersRequest{
+					PageToken: "page2",
+				}).Return(&dataplanev1.ListUsersResponse{
+					Users: []*dataplanev1.ListUsersResponse_User{
+						{Name: "bob"},
+					},
+				}, nil)
+			},
+			inputName:    "bob",
+			expectedUser: &dataplanev1.ListUsersResponse_User{Name: "bob"},
+			expectedErr:  "",
+		},
 		{
 			name: "ListUsers error",
 			setupMock: func() {
@@ -527,11 +540,7 @@ func TestFindTopicByName(t *testing.T) {
 		{
 			name: "Topic found",
 			setupMock: func() {
-				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{
-					Filter: &dataplanev1.ListTopicsRequest_Filter{
-						NameContains: "test-topic",
-					},
-				}).Return(&dataplanev1.ListTopicsResponse{
+				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{}).Return(&dataplanev1.ListTopicsResponse{
 					Topics: []*dataplanev1.ListTopicsResponse_Topic{
 						{Name: "test-topic"},
 						{Name: "another-topic"},
@@ -545,11 +554,7 @@ func TestFindTopicByName(t *testing.T) {
 		{
 			name: "Topic not found",
 			setupMock: func() {
-				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{
-					Filter: &dataplanev1.ListTopicsRequest_Filter{
-						NameContains: "non-existent-topic",
-					},
-				}).Return(&dataplanev1.ListTopicsResponse{
+				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{}).Return(&dataplanev1.ListTopicsResponse{
 					Topics: []*dataplanev1.ListTopicsResponse_Topic{
 						{Name: "test-topic"},
 						{Name: "another-topic"},
@@ -573,9 +578,6 @@ func TestFindTopicByName(t *testing.T) {
 			name: "Topic found on second page",
 			setupMock: func() {
 				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{
-					Filter: &dataplanev1.ListTopicsRequest_Filter{
-						NameContains: "xyz",
-					},
 					PageToken: "",
 				}).Return(&dataplanev1.ListTopicsResponse{
 					Topics: []*dataplanev1.ListTopicsResponse_Topic{
@@ -586,9 +588,6 @@ func TestFindTopicByName(t *testing.T) {
 				}, nil)
 
 				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{
-					Filter: &dataplanev1.ListTopicsRequest_Filter{
-						NameContains: "xyz",
-					},
 					PageToken: "page2",
 				}).Return(&dataplanev1.ListTopicsResponse{
 					Topics: []*dataplanev1.ListTopicsResponse_Topic{
@@ -606,9 +605,6 @@ func TestFindTopicByName(t *testing.T) {
 			name: "Topic not found after multiple pages",
 			setupMock: func() {
 				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{
-					Filter: &dataplanev1.ListTopicsRequest_Filter{
-						NameContains: "missing",
-					},
 					PageToken: "",
 				}).Return(&dataplanev1.ListTopicsResponse{
 					Topics: []*dataplanev1.ListTopicsResponse_Topic{
@@ -619,9 +615,6 @@ func TestFindTopicByName(t *testing.T) {
 				}, nil)
 
 				mockClient.EXPECT().ListTopics(gomock.Any(), &dataplanev1.ListTopicsRequest{
-					Filter: &dataplanev1.ListTopicsRequest_Filter{
-						NameContains: "missing",
-					},
 					PageToken: "page2",
 				}).Return(&dataplanev1.ListTopicsResponse{
 					Topics: []*dataplanev1.ListTopicsResponse_Topic{
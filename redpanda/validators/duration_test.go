@@ -0,0 +1,55 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     types.String
+		expectErr bool
+	}{
+		{"null", types.StringNull(), false},
+		{"unknown", types.StringUnknown(), false},
+		{"hours", types.StringValue("720h"), false},
+		{"mixed units", types.StringValue("1h30m"), false},
+		{"days are not a Go unit", types.StringValue("30d"), true},
+		{"zero", types.StringValue("0s"), true},
+		{"negative", types.StringValue("-1h"), true},
+		{"garbage", types.StringValue("soon"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("rotate_after"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.StringResponse
+			validators.Duration().ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}
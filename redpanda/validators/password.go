@@ -19,9 +19,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -40,11 +42,21 @@ func Password(passwordPath, passwordWOPath path.Expression) PasswordValidator {
 	}
 }
 
+// WithGenerated returns a copy of the validator that also accepts a password
+// generated by the provider. The attribute at generatedPath satisfies the
+// "at least one is set" check on its own, and conflicts with both password
+// fields.
+func (v PasswordValidator) WithGenerated(generatedPath path.Expression) PasswordValidator {
+	v.GeneratedPaths = append(append([]path.Expression(nil), v.GeneratedPaths...), generatedPath)
+	return v
+}
+
 // PasswordValidator validates password fields for minimum length and ensures
 // at least one password field is populated.
 type PasswordValidator struct {
 	PasswordPath   path.Expression
 	PasswordWOPath path.Expression
+	GeneratedPaths []path.Expression
 }
 
 // Description provides a description of the validator
@@ -89,6 +101,24 @@ func (v PasswordValidator) ValidateString(ctx context.Context, req validator.Str
 		otherPath = v.PasswordPath
 	}
 
+	if len(v.GeneratedPaths) > 0 {
+		generated, known := anyConfigured(ctx, req.Config, v.GeneratedPaths, resp)
+		if !known {
+			return
+		}
+		if generated {
+			otherIsSet, otherKnown := anyConfigured(ctx, req.Config, []path.Expression{otherPath}, resp)
+			if currentIsSet || (otherKnown && otherIsSet) {
+				resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+					req.Path,
+					"Conflicting Password Configuration",
+					fmt.Sprintf("%q cannot be combined with %q or %q", v.GeneratedPaths, v.PasswordPath, v.PasswordWOPath),
+				))
+			}
+			return
+		}
+	}
+
 	matchedPaths, diags := req.Config.PathMatches(ctx, otherPath)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
@@ -122,10 +152,47 @@ func (v PasswordValidator) ValidateString(ctx context.Context, req validator.Str
 	}
 
 	// Neither field has a non-empty value
+	detail := fmt.Sprintf("At least one of %q or %q must be set with a non-empty value",
+		v.PasswordPath, v.PasswordWOPath)
+	if len(v.GeneratedPaths) > 0 {
+		detail = fmt.Sprintf("At least one of %q or %q must be set with a non-empty value, or %q must be set",
+			v.PasswordPath, v.PasswordWOPath, v.GeneratedPaths)
+	}
 	resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
 		req.Path,
 		"Missing Required Password",
-		fmt.Sprintf("At least one of %q or %q must be set with a non-empty value",
-			v.PasswordPath, v.PasswordWOPath),
+		detail,
 	))
 }
+
+// anyConfigured reports whether any attribute matched by exprs is set to a
+// non-null, non-empty value. known is false when one of them is unknown and
+// validation has to wait for apply.
+func anyConfigured(ctx context.Context, cfg tfsdk.Config, exprs []path.Expression, resp *validator.StringResponse) (set, known bool) {
+	for _, expr := range exprs {
+		matchedPaths, diags := cfg.PathMatches(ctx, expr)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			continue
+		}
+		for _, mp := range matchedPaths {
+			var value attr.Value
+			diags = cfg.GetAttribute(ctx, mp, &value)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() || value == nil {
+				continue
+			}
+			if value.IsUnknown() {
+				return false, false
+			}
+			if value.IsNull() {
+				continue
+			}
+			if s, ok := value.(types.String); ok && s.ValueString() == "" {
+				continue
+			}
+			set = true
+		}
+	}
+	return set, true
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPasswordValidator(t *testing.T) {
@@ -327,6 +328,107 @@ func TestPasswordValidator_OtherUnknown(t *testing.T) {
 	assert.False(t, resp.Diagnostics.HasError(), "validation should be delayed when other field is unknown")
 }
 
+func TestPasswordValidator_Generated(t *testing.T) {
+	genType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"length": tftypes.Number}}
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"password":    schema.StringAttribute{Optional: true},
+			"password_wo": schema.StringAttribute{Optional: true},
+			"generate_password": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"length": schema.Int64Attribute{Optional: true},
+				},
+			},
+		},
+	}
+	generated := tftypes.NewValue(genType, map[string]tftypes.Value{"length": tftypes.NewValue(tftypes.Number, 32)})
+
+	testCases := []struct {
+		name         string
+		password     *string
+		passwordWO   *string
+		generate     tftypes.Value
+		errorSummary string
+	}{
+		{
+			name:     "generated password alone is valid",
+			generate: generated,
+		},
+		{
+			name:         "generated password conflicts with password",
+			password:     strPtr("secret"),
+			generate:     generated,
+			errorSummary: "Conflicting Password Configuration",
+		},
+		{
+			name:         "generated password conflicts with password_wo",
+			passwordWO:   strPtr("secret"),
+			generate:     generated,
+			errorSummary: "Conflicting Password Configuration",
+		},
+		{
+			name:     "unknown generate_password delays validation",
+			generate: tftypes.NewValue(genType, tftypes.UnknownValue),
+		},
+		{
+			name:         "nothing set still fails",
+			generate:     tftypes.NewValue(genType, nil),
+			errorSummary: "Missing Required Password",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			passwordValue := tftypes.NewValue(tftypes.String, nil)
+			configValue := types.StringNull()
+			if tc.password != nil {
+				passwordValue = tftypes.NewValue(tftypes.String, *tc.password)
+				configValue = types.StringValue(*tc.password)
+			}
+			passwordWOValue := tftypes.NewValue(tftypes.String, nil)
+			if tc.passwordWO != nil {
+				passwordWOValue = tftypes.NewValue(tftypes.String, *tc.passwordWO)
+			}
+			rawValue := tftypes.NewValue(
+				tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"password":          tftypes.String,
+						"password_wo":       tftypes.String,
+						"generate_password": genType,
+					},
+				},
+				map[string]tftypes.Value{
+					"password":          passwordValue,
+					"password_wo":       passwordWOValue,
+					"generate_password": tc.generate,
+				},
+			)
+
+			req := validator.StringRequest{
+				Path:           path.Root("password"),
+				PathExpression: path.Root("password").Expression(),
+				ConfigValue:    configValue,
+				Config:         tfsdk.Config{Schema: testSchema, Raw: rawValue},
+			}
+			var resp validator.StringResponse
+
+			v := validators.Password(
+				path.MatchRoot("password"),
+				path.MatchRoot("password_wo"),
+			).WithGenerated(path.MatchRoot("generate_password"))
+			v.ValidateString(context.Background(), req, &resp)
+
+			if tc.errorSummary == "" {
+				assert.False(t, resp.Diagnostics.HasError(), "unexpected validation error: %v", resp.Diagnostics.Errors())
+				return
+			}
+			require.True(t, resp.Diagnostics.HasError(), "expected validation error but got none")
+			assert.Equal(t, tc.errorSummary, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
+
 func TestPasswordValidator_Description(t *testing.T) {
 	v := validators.Password(
 		path.MatchRoot("password"),
@@ -40,6 +40,23 @@ func PrincipalPrefix() validator.String {
 	)
 }
 
+// aclPrincipalPattern is the wider set of principal types an ACL can be
+// granted to: SASL and mTLS users ("User:", including the "User:*"
+// wildcard), OIDC group claims ("Group:") and Redpanda roles
+// ("RedpandaRole:"). mTLS principals are mapped to "User:" by the cluster's
+// kafka_mtls_principal_mapping_rules, so they need no prefix of their own.
+var aclPrincipalPattern = regexp.MustCompile(`^(User|Group|RedpandaRole):.+$`)
+
+// ACLPrincipal returns a validator that rejects ACL principals lacking a
+// "User:", "Group:" or "RedpandaRole:" prefix. The broker stores unprefixed
+// principals verbatim, so an ACL for "alice" silently never matches anyone.
+func ACLPrincipal() validator.String {
+	return stringvalidator.RegexMatches(
+		aclPrincipalPattern,
+		`principal must be prefixed with "User:", "Group:" or "RedpandaRole:"`,
+	)
+}
+
 // CanonicalizePrincipal returns the canonical form a Kafka-style principal
 // would take on the wire: an input already carrying a "User:", "Group:",
 // or "RedpandaRole:" prefix is returned unchanged; any other input has
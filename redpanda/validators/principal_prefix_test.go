@@ -85,3 +85,39 @@ func TestPrincipalPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestACLPrincipal(t *testing.T) {
+	cases := []struct {
+		name      string
+		input     types.String
+		wantError bool
+	}{
+		{"User: prefix accepted", types.StringValue("User:alice"), false},
+		{"User wildcard accepted", types.StringValue("User:*"), false},
+		{"mTLS-mapped DN accepted", types.StringValue("User:CN=alice,O=Example"), false},
+		{"Group: prefix accepted", types.StringValue("Group:engineers"), false},
+		{"RedpandaRole: prefix accepted", types.StringValue("RedpandaRole:admin"), false},
+		{"bare username rejected", types.StringValue("alice"), true},
+		{"unknown prefix rejected", types.StringValue("ServiceAccount:svc"), true},
+		{"lowercase group: rejected", types.StringValue("group:engineers"), true},
+		{"empty after prefix rejected", types.StringValue("RedpandaRole:"), true},
+		{"null skipped", types.StringNull(), false},
+		{"unknown skipped", types.StringUnknown(), false},
+	}
+
+	v := validators.ACLPrincipal()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("principal"),
+				ConfigValue: tc.input,
+			}
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tc.wantError {
+				t.Errorf("want error=%v, got error=%v (diags=%v)", tc.wantError, got, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -20,13 +20,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
 	_ validator.String = SchemaRegistryResourceTypeValidator{}
 	_ validator.String = SchemaRegistryPatternTypeValidator{}
 	_ validator.String = SchemaRegistryPermissionValidator{}
+	_ validator.String = SchemaRegistryContextValidator{}
 )
 
 // SchemaRegistryResourceTypeValidator validates that a Schema Registry resource type is valid
@@ -166,3 +169,47 @@ func (SchemaRegistryPermissionValidator) ValidateString(_ context.Context, req v
 func SchemaRegistryPermission() validator.String {
 	return SchemaRegistryPermissionValidator{}
 }
+
+// SchemaRegistryContextValidator validates that a Schema Registry context is
+// only set on SUBJECT ACLs
+type SchemaRegistryContextValidator struct{}
+
+// Description provides a description of the validator
+func (SchemaRegistryContextValidator) Description(_ context.Context) string {
+	return "may only be set when resource_type is SUBJECT"
+}
+
+// MarkdownDescription provides a description of the validator in markdown format
+func (SchemaRegistryContextValidator) MarkdownDescription(_ context.Context) string {
+	return "may only be set when `resource_type` is `SUBJECT`"
+}
+
+// ValidateString validates that the context is paired with a SUBJECT resource type
+func (SchemaRegistryContextValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip validation if the value is unknown or null
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	var resourceType types.String
+	if diags := req.Config.GetAttribute(ctx, path.Root("resource_type"), &resourceType); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	if resourceType.IsUnknown() || resourceType.IsNull() {
+		return
+	}
+
+	if resourceType.ValueString() != "SUBJECT" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Schema Registry Context",
+			fmt.Sprintf("context can only be set for SUBJECT ACLs, got resource_type: %s", resourceType.ValueString()),
+		)
+	}
+}
+
+// SchemaRegistryContext returns a validator for the context of Schema Registry ACLs
+func SchemaRegistryContext() validator.String {
+	return SchemaRegistryContextValidator{}
+}
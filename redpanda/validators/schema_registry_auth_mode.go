@@ -0,0 +1,105 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils"
+)
+
+var _ validator.String = SchemaRegistryAuthModeValidator{}
+
+// SchemaRegistryAuthModeValidator validates the auth_mode of Schema Registry
+// resources against the credentials configured alongside it:
+// 1. provider_token must not be combined with username, password or password_wo
+// 2. basic requires username and one of password or password_wo
+type SchemaRegistryAuthModeValidator struct{}
+
+// Description provides a description of the validator
+func (v SchemaRegistryAuthModeValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription provides a description of the validator in markdown format
+func (SchemaRegistryAuthModeValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be one of: `provider_token`, `basic`; `basic` requires `username` and a password, `provider_token` forbids them"
+}
+
+// ValidateString validates auth_mode and the credentials it depends on
+func (SchemaRegistryAuthModeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// Skip validation if the value is unknown or null
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	credentials := []string{"username", "password", "password_wo"}
+	values := make(map[string]types.String, len(credentials))
+	for _, name := range credentials {
+		var v types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &v)...)
+		values[name] = v
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch mode := req.ConfigValue.ValueString(); mode {
+	case utils.SchemaRegistryAuthModeProviderToken:
+		for _, name := range credentials {
+			if !values[name].IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					req.Path,
+					"Conflicting Schema Registry credentials",
+					fmt.Sprintf("%s cannot be set when auth_mode is %q: the provider's cloud token is used instead. Remove %s or set auth_mode = %q.",
+						name, mode, name, utils.SchemaRegistryAuthModeBasic),
+				)
+			}
+		}
+	case utils.SchemaRegistryAuthModeBasic:
+		if values["username"].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Missing Schema Registry credentials",
+				fmt.Sprintf("username is required when auth_mode is %q", mode),
+			)
+		}
+		if values["password"].IsNull() && values["password_wo"].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Missing Schema Registry credentials",
+				fmt.Sprintf("one of password or password_wo is required when auth_mode is %q", mode),
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Schema Registry Auth Mode",
+			fmt.Sprintf("auth_mode must be one of [%s, %s], got: %s",
+				utils.SchemaRegistryAuthModeProviderToken, utils.SchemaRegistryAuthModeBasic, mode),
+		)
+	}
+}
+
+// SchemaRegistryAuthMode returns a validator for the auth_mode of Schema
+// Registry resources
+func SchemaRegistryAuthMode() validator.String {
+	return SchemaRegistryAuthModeValidator{}
+}
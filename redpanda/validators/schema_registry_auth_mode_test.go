@@ -0,0 +1,154 @@
+// Copyright 2023 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaRegistryAuthModeValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authMode      *string
+		username      *string
+		password      *string
+		passwordWO    *string
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:     "null auth_mode is not validated",
+			username: strPtr("alice"),
+		},
+		{
+			name:     "provider_token without credentials",
+			authMode: strPtr("provider_token"),
+		},
+		{
+			name:          "provider_token with username",
+			authMode:      strPtr("provider_token"),
+			username:      strPtr("alice"),
+			expectError:   true,
+			errorContains: "username cannot be set",
+		},
+		{
+			name:          "provider_token with password_wo",
+			authMode:      strPtr("provider_token"),
+			passwordWO:    strPtr("secret"),
+			expectError:   true,
+			errorContains: "password_wo cannot be set",
+		},
+		{
+			name:     "basic with username and password",
+			authMode: strPtr("basic"),
+			username: strPtr("alice"),
+			password: strPtr("secret"),
+		},
+		{
+			name:       "basic with username and password_wo",
+			authMode:   strPtr("basic"),
+			username:   strPtr("alice"),
+			passwordWO: strPtr("secret"),
+		},
+		{
+			name:          "basic without username",
+			authMode:      strPtr("basic"),
+			password:      strPtr("secret"),
+			expectError:   true,
+			errorContains: "username is required",
+		},
+		{
+			name:          "basic without password",
+			authMode:      strPtr("basic"),
+			username:      strPtr("alice"),
+			expectError:   true,
+			errorContains: "one of password or password_wo is required",
+		},
+		{
+			name:          "unknown mode",
+			authMode:      strPtr("sasl"),
+			expectError:   true,
+			errorContains: "auth_mode must be one of",
+		},
+	}
+
+	stringValue := func(s *string) tftypes.Value {
+		if s == nil {
+			return tftypes.NewValue(tftypes.String, nil)
+		}
+		return tftypes.NewValue(tftypes.String, *s)
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"auth_mode":   schema.StringAttribute{Optional: true},
+					"username":    schema.StringAttribute{Optional: true},
+					"password":    schema.StringAttribute{Optional: true},
+					"password_wo": schema.StringAttribute{Optional: true},
+				},
+			}
+			config := tfsdk.Config{
+				Schema: testSchema,
+				Raw: tftypes.NewValue(
+					tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+						"auth_mode":   tftypes.String,
+						"username":    tftypes.String,
+						"password":    tftypes.String,
+						"password_wo": tftypes.String,
+					}},
+					map[string]tftypes.Value{
+						"auth_mode":   stringValue(tc.authMode),
+						"username":    stringValue(tc.username),
+						"password":    stringValue(tc.password),
+						"password_wo": stringValue(tc.passwordWO),
+					},
+				),
+			}
+
+			configValue := types.StringNull()
+			if tc.authMode != nil {
+				configValue = types.StringValue(*tc.authMode)
+			}
+			req := validator.StringRequest{
+				Path:           path.Root("auth_mode"),
+				PathExpression: path.MatchRoot("auth_mode"),
+				ConfigValue:    configValue,
+				Config:         config,
+			}
+			var resp validator.StringResponse
+			validators.SchemaRegistryAuthMode().ValidateString(context.Background(), req, &resp)
+
+			if !tc.expectError {
+				assert.False(t, resp.Diagnostics.HasError(), "unexpected error: %v", resp.Diagnostics)
+				return
+			}
+			assert.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tc.errorContains)
+		})
+	}
+}
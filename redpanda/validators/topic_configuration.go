@@ -0,0 +1,76 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/topicconfig"
+)
+
+var _ validator.Map = TopicConfigurationValidator{}
+
+// TopicConfiguration returns a TopicConfigurationValidator.
+func TopicConfiguration() TopicConfigurationValidator {
+	return TopicConfigurationValidator{}
+}
+
+// TopicConfigurationValidator checks topic configuration keys and values
+// against the bundled catalog of Redpanda topic properties.
+type TopicConfigurationValidator struct{}
+
+// Description provides a description of the validator
+func (v TopicConfigurationValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription provides a description of the validator in markdown format
+func (TopicConfigurationValidator) MarkdownDescription(_ context.Context) string {
+	return "keys must be Redpanda topic properties and values must match the property's type, allowed values and range"
+}
+
+// ValidateMap validates each configuration entry. A key close to a known
+// property is rejected as a likely typo; other unknown keys only warn so
+// properties newer than the catalog still reach the broker.
+func (TopicConfigurationValidator) ValidateMap(_ context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for key, v := range req.ConfigValue.Elements() {
+		keyPath := req.Path.AtMapKey(key)
+		if _, ok := topicconfig.Lookup(key); !ok {
+			if s := topicconfig.Suggest(key, topicconfig.Names()); s != "" {
+				resp.Diagnostics.AddAttributeError(keyPath, "Unknown topic configuration",
+					fmt.Sprintf("%q is not a Redpanda topic property. Did you mean %q?", key, s))
+			} else {
+				resp.Diagnostics.AddAttributeWarning(keyPath, "Unrecognized topic configuration",
+					fmt.Sprintf("%q is not in the provider's catalog of topic properties; it is sent to Redpanda unchanged.", key))
+			}
+			continue
+		}
+		s, ok := v.(types.String)
+		if !ok || s.IsNull() || s.IsUnknown() {
+			continue
+		}
+		if err := topicconfig.Validate(key, s.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(keyPath, "Invalid topic configuration value",
+				fmt.Sprintf("%s: %v", key, err))
+		}
+	}
+}
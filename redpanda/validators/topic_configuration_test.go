@@ -0,0 +1,81 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopicConfigurationValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       types.Map
+		wantError   string
+		wantWarning bool
+	}{
+		{name: "null", value: types.MapNull(types.StringType)},
+		{name: "unknown", value: types.MapUnknown(types.StringType)},
+		{name: "valid", value: topicConfig(map[string]attr.Value{
+			"retention.ms":   types.StringValue("7d"),
+			"cleanup.policy": types.StringValue("compact,delete"),
+		})},
+		{name: "unknown value is skipped", value: topicConfig(map[string]attr.Value{
+			"retention.ms": types.StringUnknown(),
+		})},
+		{name: "key typo", value: topicConfig(map[string]attr.Value{
+			"retention.msg": types.StringValue("1000"),
+		}), wantError: `Did you mean "retention.ms"?`},
+		{name: "value typo", value: topicConfig(map[string]attr.Value{
+			"cleanup.policy": types.StringValue("compactt"),
+		}), wantError: `Did you mean "compact"?`},
+		{name: "out of range", value: topicConfig(map[string]attr.Value{
+			"min.cleanable.dirty.ratio": types.StringValue("2"),
+		}), wantError: "must be at most 1"},
+		{name: "unrecognized key warns", value: topicConfig(map[string]attr.Value{
+			"x.vendor.feature": types.StringValue("on"),
+		}), wantWarning: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.MapRequest{
+				Path:        path.Root("configuration"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.MapResponse
+			validators.TopicConfiguration().ValidateMap(context.Background(), req, &resp)
+			if tt.wantError == "" {
+				assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			} else {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.wantError)
+			}
+			assert.Equal(t, tt.wantWarning, resp.Diagnostics.WarningsCount() > 0)
+		})
+	}
+}
+
+func topicConfig(elems map[string]attr.Value) types.Map {
+	return types.MapValueMust(types.StringType, elems)
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/utils/topicconfig"
+)
+
+var _ validator.String = TopicPropertyValidator{}
+
+// TopicProperty returns a TopicPropertyValidator for the named topic property.
+func TopicProperty(name string) TopicPropertyValidator {
+	return TopicPropertyValidator{name: name}
+}
+
+// TopicPropertyValidator checks a string attribute that is written to a
+// single topic property against that property's entry in the topic
+// configuration catalog.
+type TopicPropertyValidator struct {
+	name string
+}
+
+// Description provides a description of the validator
+func (v TopicPropertyValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+// MarkdownDescription provides a description of the validator in markdown format
+func (v TopicPropertyValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be valid for the `%s` topic property", v.name)
+}
+
+// ValidateString validates the value against the topic property catalog
+func (v TopicPropertyValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := topicconfig.Validate(v.name, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid topic property value", fmt.Sprintf("%s: %v", v.name, err))
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Redpanda Data, Inc.
+//
+//
+//    Licensed under the Apache License, Version 2.0 (the "License");
+//    you may not use this file except in compliance with the License.
+//    You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//    Unless required by applicable law or agreed to in writing, software
+//    distributed under the License is distributed on an "AS IS" BASIS,
+//    WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//    See the License for the specific language governing permissions and
+//    limitations under the License.
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/redpanda-data/terraform-provider-redpanda/redpanda/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicPropertyValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		property  string
+		value     types.String
+		expectErr bool
+	}{
+		{"null", "redpanda.iceberg.mode", types.StringNull(), false},
+		{"unknown", "redpanda.iceberg.mode", types.StringUnknown(), false},
+		{"enum", "redpanda.iceberg.mode", types.StringValue("key_value"), false},
+		{"enum with options", "redpanda.iceberg.mode", types.StringValue("value_schema_latest:subject=orders"), false},
+		{"enum typo", "redpanda.iceberg.mode", types.StringValue("key_values"), true},
+		{"partition spec", "redpanda.iceberg.partition.spec", types.StringValue("(hour(redpanda.timestamp))"), false},
+		{"partition spec without parentheses", "redpanda.iceberg.partition.spec", types.StringValue("hour(redpanda.timestamp)"), true},
+		{"partition spec unknown transform", "redpanda.iceberg.partition.spec", types.StringValue("(hours(redpanda.timestamp))"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("iceberg").AtName("mode"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.StringResponse
+			validators.TopicProperty(tt.property).ValidateString(context.Background(), req, &resp)
+			assert.Equal(t, tt.expectErr, resp.Diagnostics.HasError(), resp.Diagnostics)
+		})
+	}
+}